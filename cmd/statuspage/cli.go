@@ -0,0 +1,250 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/nagelflorian/statuspage-go"
+	"github.com/nagelflorian/statuspage-go/internal/yaml"
+)
+
+// config is the content of the config file.
+type config struct {
+	Token  string `json:"token"`
+	PageID string `json:"page_id"`
+}
+
+// cli holds the state of a single command invocation.
+type cli struct {
+	name   string
+	usage  string
+	stdout io.Writer
+	stderr io.Writer
+	getenv func(string) string
+
+	output     string
+	pageID     string
+	configPath string
+	timeout    time.Duration
+
+	config *config
+}
+
+// flagSet returns a flag set for the command with the global flags
+// registered.
+func (c *cli) flagSet() *flag.FlagSet {
+	flags := flag.NewFlagSet("statuspage "+c.name, flag.ContinueOnError)
+	// Errors are reported by run, help is printed by parse.
+	flags.SetOutput(io.Discard)
+	flags.Usage = func() {}
+	c.globalFlags(flags)
+	return flags
+}
+
+func (c *cli) globalFlags(flags *flag.FlagSet) {
+	flags.StringVar(&c.output, "output", "table", "output `format`: table, json or yaml")
+	flags.StringVar(&c.output, "o", "table", "shorthand for --output")
+	flags.StringVar(&c.pageID, "page", "", "page `id`, defaults to STATUSPAGE_PAGE_ID or the config file")
+	flags.StringVar(&c.configPath, "config", "", "config `file`, defaults to STATUSPAGE_CONFIG or statuspage/config.json in the user config directory")
+	flags.DurationVar(&c.timeout, "timeout", defaultTimeout, "time limit of the command")
+}
+
+// parse parses args with flags, allowing flags between positional arguments,
+// and returns the positional arguments. It fails unless there are exactly
+// nargs of them.
+func (c *cli) parse(flags *flag.FlagSet, args []string, nargs int) ([]string, error) {
+	var positional []string
+	for {
+		if err := flags.Parse(args); err != nil {
+			if errors.Is(err, flag.ErrHelp) {
+				fmt.Fprintf(c.stdout, "usage: statuspage %s\n", strings.TrimSpace(c.name+" "+c.usage))
+				flags.SetOutput(c.stdout)
+				flags.PrintDefaults()
+				return nil, err
+			}
+			return nil, &usageError{err.Error()}
+		}
+		args = flags.Args()
+		if len(args) == 0 {
+			break
+		}
+		positional = append(positional, args[0])
+		args = args[1:]
+	}
+
+	switch c.output {
+	case "table", "json", "yaml":
+	default:
+		return nil, usagef("invalid output format %q, want table, json or yaml", c.output)
+	}
+	if len(positional) != nargs {
+		return nil, usagef("%s takes %d argument(s), got %d", c.name, nargs, len(positional))
+	}
+	return positional, nil
+}
+
+// loadConfig reads the config file. A missing config file is only an error
+// if it was named explicitly.
+func (c *cli) loadConfig() (*config, error) {
+	if c.config != nil {
+		return c.config, nil
+	}
+
+	path, explicit := c.configPath, true
+	if path == "" {
+		path = c.getenv("STATUSPAGE_CONFIG")
+	}
+	if path == "" {
+		explicit = false
+		dir, err := os.UserConfigDir()
+		if err != nil {
+			c.config = &config{}
+			return c.config, nil
+		}
+		path = filepath.Join(dir, "statuspage", "config.json")
+	}
+
+	cfg := &config{}
+	data, err := os.ReadFile(path)
+	switch {
+	case errors.Is(err, fs.ErrNotExist) && !explicit:
+	case err != nil:
+		return nil, fmt.Errorf("reading config: %w", err)
+	default:
+		if err := json.Unmarshal(data, cfg); err != nil {
+			return nil, fmt.Errorf("parsing config %s: %w", path, err)
+		}
+	}
+
+	c.config = cfg
+	return cfg, nil
+}
+
+// client returns a client authenticating with the configured token.
+func (c *cli) client() (*statuspage.Client, error) {
+	token := c.getenv("STATUSPAGE_TOKEN")
+	if token == "" {
+		cfg, err := c.loadConfig()
+		if err != nil {
+			return nil, err
+		}
+		token = cfg.Token
+	}
+	if token == "" {
+		return nil, errors.New("no API token: set STATUSPAGE_TOKEN or add a token to the config file")
+	}
+
+	opts := []statuspage.Option{
+		statuspage.WithRetryPolicy(statuspage.DefaultRetryPolicy()),
+		statuspage.WithUserAgent("statuspage-cli"),
+	}
+	if baseURL := c.getenv("STATUSPAGE_BASE_URL"); baseURL != "" {
+		u, err := url.Parse(baseURL)
+		if err != nil {
+			return nil, fmt.Errorf("invalid STATUSPAGE_BASE_URL: %w", err)
+		}
+		if !strings.HasSuffix(u.Path, "/") {
+			u.Path += "/"
+		}
+		opts = append(opts, statuspage.WithBaseURL(u))
+	}
+
+	return statuspage.NewClient(token, opts...), nil
+}
+
+// page returns the page id of the command.
+func (c *cli) page() (string, error) {
+	if c.pageID != "" {
+		return c.pageID, nil
+	}
+	if pageID := c.getenv("STATUSPAGE_PAGE_ID"); pageID != "" {
+		return pageID, nil
+	}
+	cfg, err := c.loadConfig()
+	if err != nil {
+		return "", err
+	}
+	if cfg.PageID == "" {
+		return "", usagef("no page: pass --page, set STATUSPAGE_PAGE_ID or add a page_id to the config file")
+	}
+	return cfg.PageID, nil
+}
+
+// setup returns the client, page id and context of a command working on a
+// page. The caller must call the returned cancel function.
+func (c *cli) setup(ctx context.Context) (*statuspage.Client, string, context.Context, context.CancelFunc, error) {
+	pageID, err := c.page()
+	if err != nil {
+		return nil, "", nil, nil, err
+	}
+	client, err := c.client()
+	if err != nil {
+		return nil, "", nil, nil, err
+	}
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	return client, pageID, ctx, cancel, nil
+}
+
+// column is a column of table output.
+type column[T any] struct {
+	header string
+	value  func(T) string
+}
+
+// render prints v as JSON or YAML, or rows as a table, depending on the
+// output format.
+func render[T any](c *cli, v interface{}, rows []T, columns []column[T]) error {
+	switch c.output {
+	case "json":
+		enc := json.NewEncoder(c.stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(v)
+	case "yaml":
+		data, err := yaml.Marshal(v)
+		if err != nil {
+			return err
+		}
+		_, err = c.stdout.Write(data)
+		return err
+	}
+
+	w := tabwriter.NewWriter(c.stdout, 0, 0, 2, ' ', 0)
+	headers := make([]string, len(columns))
+	for i, col := range columns {
+		headers[i] = col.header
+	}
+	fmt.Fprintln(w, strings.Join(headers, "\t"))
+	for _, row := range rows {
+		values := make([]string, len(columns))
+		for i, col := range columns {
+			values[i] = col.value(row)
+		}
+		fmt.Fprintln(w, strings.Join(values, "\t"))
+	}
+	return w.Flush()
+}
+
+func str(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+func timestamp(t *statuspage.Timestamp) string {
+	if t == nil {
+		return ""
+	}
+	return t.Time.Format(time.RFC3339)
+}
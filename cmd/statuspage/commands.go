@@ -0,0 +1,405 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/nagelflorian/statuspage-go"
+	"github.com/nagelflorian/statuspage-go/pagesync"
+)
+
+var pageColumns = []column[statuspage.Page]{
+	{"ID", func(p statuspage.Page) string { return str(p.ID) }},
+	{"NAME", func(p statuspage.Page) string { return str(p.Name) }},
+	{"URL", func(p statuspage.Page) string { return str(p.URL) }},
+}
+
+var componentColumns = []column[statuspage.Component]{
+	{"ID", func(c statuspage.Component) string { return str(c.ID) }},
+	{"NAME", func(c statuspage.Component) string { return str(c.Name) }},
+	{"STATUS", func(c statuspage.Component) string { return str(c.Status) }},
+	{"GROUP", func(c statuspage.Component) string { return str(c.GroupID) }},
+}
+
+var incidentColumns = []column[statuspage.Incident]{
+	{"ID", func(i statuspage.Incident) string { return str(i.ID) }},
+	{"NAME", func(i statuspage.Incident) string { return str(i.Name) }},
+	{"STATUS", func(i statuspage.Incident) string { return str(i.Status) }},
+	{"IMPACT", func(i statuspage.Incident) string { return str(i.Impact) }},
+	{"CREATED", func(i statuspage.Incident) string { return timestamp(i.CreatedAt) }},
+}
+
+func (c *cli) listPages(ctx context.Context, args []string) error {
+	if _, err := c.parse(c.flagSet(), args, 0); err != nil {
+		return err
+	}
+	client, err := c.client()
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	pages := []statuspage.Page{}
+	for page, err := range client.Page.All(ctx) {
+		if err != nil {
+			return err
+		}
+		pages = append(pages, page)
+	}
+	return render(c, pages, pages, pageColumns)
+}
+
+func (c *cli) syncPage(ctx context.Context, args []string) error {
+	flags := c.flagSet()
+	dryRun := flags.Bool("dry-run", false, "print the changes without making them")
+	args, err := c.parse(flags, args, 1)
+	if err != nil {
+		return err
+	}
+	data, err := os.ReadFile(args[0])
+	if err != nil {
+		return err
+	}
+	config, err := pagesync.Parse(data)
+	if err != nil {
+		return err
+	}
+	client, pageID, ctx, cancel, err := c.setup(ctx)
+	if err != nil {
+		return err
+	}
+	defer cancel()
+
+	plan, err := pagesync.NewPlan(ctx, client, pageID, config)
+	if err != nil {
+		return err
+	}
+	opts := &pagesync.ApplyOptions{DryRun: *dryRun}
+	if c.output == "table" {
+		fmt.Fprint(c.stdout, plan)
+		if !plan.Empty() && !*dryRun {
+			fmt.Fprintln(c.stdout)
+			opts.Progress = c.stdout
+		}
+	} else if err := render[pagesync.Change](c, plan, nil, nil); err != nil {
+		return err
+	}
+	return plan.Apply(ctx, client, opts)
+}
+
+func (c *cli) listComponents(ctx context.Context, args []string) error {
+	if _, err := c.parse(c.flagSet(), args, 0); err != nil {
+		return err
+	}
+	client, pageID, ctx, cancel, err := c.setup(ctx)
+	if err != nil {
+		return err
+	}
+	defer cancel()
+
+	components := []statuspage.Component{}
+	for component, err := range client.Component.All(ctx, pageID) {
+		if err != nil {
+			return err
+		}
+		components = append(components, component)
+	}
+	return render(c, components, components, componentColumns)
+}
+
+func (c *cli) showComponent(ctx context.Context, args []string) error {
+	args, err := c.parse(c.flagSet(), args, 1)
+	if err != nil {
+		return err
+	}
+	client, pageID, ctx, cancel, err := c.setup(ctx)
+	if err != nil {
+		return err
+	}
+	defer cancel()
+
+	component, _, err := client.Component.GetComponent(ctx, pageID, args[0])
+	if err != nil {
+		return err
+	}
+	return render(c, component, []statuspage.Component{*component}, componentColumns)
+}
+
+func (c *cli) setComponentStatus(ctx context.Context, args []string) error {
+	args, err := c.parse(c.flagSet(), args, 2)
+	if err != nil {
+		return err
+	}
+	status := statuspage.ComponentStatus(args[1])
+	if !status.Valid() {
+		return usagef("invalid component status %q", args[1])
+	}
+	client, pageID, ctx, cancel, err := c.setup(ctx)
+	if err != nil {
+		return err
+	}
+	defer cancel()
+
+	component, _, err := client.Component.UpdateComponent(ctx, pageID, args[0], statuspage.UpdateComponentParams{
+		Status: status,
+	})
+	if err != nil {
+		return err
+	}
+	return render(c, component, []statuspage.Component{*component}, componentColumns)
+}
+
+func (c *cli) listIncidents(ctx context.Context, args []string) error {
+	flags := c.flagSet()
+	unresolved := flags.Bool("unresolved", false, "only list unresolved incidents")
+	if _, err := c.parse(flags, args, 0); err != nil {
+		return err
+	}
+	client, pageID, ctx, cancel, err := c.setup(ctx)
+	if err != nil {
+		return err
+	}
+	defer cancel()
+
+	incidents := []statuspage.Incident{}
+	if *unresolved {
+		list, _, err := client.Incident.ListUnresolvedIncidents(ctx, pageID, nil)
+		if err != nil {
+			return err
+		}
+		incidents = *list
+	} else {
+		for incident, err := range client.Incident.All(ctx, pageID) {
+			if err != nil {
+				return err
+			}
+			incidents = append(incidents, incident)
+		}
+	}
+	return render(c, incidents, incidents, incidentColumns)
+}
+
+func (c *cli) showIncident(ctx context.Context, args []string) error {
+	args, err := c.parse(c.flagSet(), args, 1)
+	if err != nil {
+		return err
+	}
+	client, pageID, ctx, cancel, err := c.setup(ctx)
+	if err != nil {
+		return err
+	}
+	defer cancel()
+
+	incident, _, err := client.Incident.GetIncident(ctx, pageID, args[0])
+	if err != nil {
+		return err
+	}
+	return render(c, incident, []statuspage.Incident{*incident}, incidentColumns)
+}
+
+func (c *cli) openIncident(ctx context.Context, args []string) error {
+	flags := c.flagSet()
+	name := flags.String("name", "", "incident `name` (required)")
+	status := flags.String("status", string(statuspage.IncidentStatusInvestigating), "incident `status`")
+	impact := flags.String("impact", "", "impact override: none, minor, major or critical")
+	body := flags.String("body", "", "`text` of the first incident update")
+	components := componentStatuses{}
+	flags.Var(components, "component", "affected component as `id=status`, may be repeated")
+	if _, err := c.parse(flags, args, 0); err != nil {
+		return err
+	}
+	if *name == "" {
+		return usagef("--name is required")
+	}
+	params := statuspage.CreateIncidentParams{
+		Name:           *name,
+		Status:         statuspage.IncidentStatus(*status),
+		ImpactOverride: statuspage.IncidentImpact(*impact),
+		Body:           *body,
+	}
+	if err := components.apply(&params.Components, &params.ComponentIDs); err != nil {
+		return err
+	}
+	if !params.Status.Valid() {
+		return usagef("invalid incident status %q", *status)
+	}
+	if *impact != "" && !params.ImpactOverride.Valid() {
+		return usagef("invalid incident impact %q", *impact)
+	}
+	client, pageID, ctx, cancel, err := c.setup(ctx)
+	if err != nil {
+		return err
+	}
+	defer cancel()
+
+	incident, _, err := client.Incident.CreateIncident(ctx, pageID, params)
+	if err != nil {
+		return err
+	}
+	return render(c, incident, []statuspage.Incident{*incident}, incidentColumns)
+}
+
+func (c *cli) updateIncident(ctx context.Context, args []string) error {
+	flags := c.flagSet()
+	status := flags.String("status", "", "new incident `status`")
+	body := flags.String("body", "", "`text` of the incident update")
+	components := componentStatuses{}
+	flags.Var(components, "component", "affected component as `id=status`, may be repeated")
+	args, err := c.parse(flags, args, 1)
+	if err != nil {
+		return err
+	}
+	params := statuspage.UpdateIncidentParams{
+		Status: statuspage.IncidentStatus(*status),
+		Body:   *body,
+	}
+	if err := components.apply(&params.Components, &params.ComponentIDs); err != nil {
+		return err
+	}
+	if *status != "" && !params.Status.Valid() {
+		return usagef("invalid incident status %q", *status)
+	}
+	client, pageID, ctx, cancel, err := c.setup(ctx)
+	if err != nil {
+		return err
+	}
+	defer cancel()
+
+	incident, _, err := client.Incident.UpdateIncident(ctx, pageID, args[0], params)
+	if err != nil {
+		return err
+	}
+	return render(c, incident, []statuspage.Incident{*incident}, incidentColumns)
+}
+
+func (c *cli) resolveIncident(ctx context.Context, args []string) error {
+	flags := c.flagSet()
+	body := flags.String("body", "", "`text` of the final incident update")
+	args, err := c.parse(flags, args, 1)
+	if err != nil {
+		return err
+	}
+	client, pageID, ctx, cancel, err := c.setup(ctx)
+	if err != nil {
+		return err
+	}
+	defer cancel()
+
+	incident, _, err := client.Incident.ResolveIncident(ctx, pageID, args[0], *body)
+	if err != nil {
+		return err
+	}
+	return render(c, incident, []statuspage.Incident{*incident}, incidentColumns)
+}
+
+func (c *cli) scheduleMaintenance(ctx context.Context, args []string) error {
+	flags := c.flagSet()
+	name := flags.String("name", "", "maintenance `name` (required)")
+	start := flags.String("start", "", "start `time` in RFC 3339 format (required)")
+	end := flags.String("end", "", "end `time` in RFC 3339 format (required)")
+	body := flags.String("body", "", "`text` describing the maintenance")
+	var componentIDs stringList
+	flags.Var(&componentIDs, "component", "affected component `id`, may be repeated")
+	autoTransition := flags.Bool("auto-transition", false, "move the maintenance and its components through its states automatically")
+	if _, err := c.parse(flags, args, 0); err != nil {
+		return err
+	}
+
+	window := statuspage.MaintenanceWindow{
+		Name:           *name,
+		Body:           *body,
+		ComponentIDs:   componentIDs,
+		AutoTransition: *autoTransition,
+	}
+	var err error
+	if window.Start, err = parseTime("--start", *start); err != nil {
+		return err
+	}
+	if window.End, err = parseTime("--end", *end); err != nil {
+		return err
+	}
+	if _, err := window.CreateIncidentParams(); err != nil {
+		return &usageError{err.Error()}
+	}
+	client, pageID, ctx, cancel, err := c.setup(ctx)
+	if err != nil {
+		return err
+	}
+	defer cancel()
+
+	incident, _, err := client.Incident.ScheduleMaintenance(ctx, pageID, window)
+	if err != nil {
+		return err
+	}
+	return render(c, incident, []statuspage.Incident{*incident}, incidentColumns)
+}
+
+func parseTime(flagName, value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, usagef("%s is required", flagName)
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, usagef("invalid %s time %q, want RFC 3339 like 2006-01-02T15:04:05Z", flagName, value)
+	}
+	return t, nil
+}
+
+// componentStatuses is a repeatable flag of id=status pairs. An id without
+// status marks the component as affected without changing its status.
+type componentStatuses map[string]string
+
+func (s componentStatuses) String() string {
+	pairs := make([]string, 0, len(s))
+	for _, id := range slices.Sorted(maps.Keys(s)) {
+		pairs = append(pairs, id+"="+s[id])
+	}
+	return strings.Join(pairs, ",")
+}
+
+func (s componentStatuses) Set(value string) error {
+	id, status, _ := strings.Cut(value, "=")
+	if id == "" {
+		return fmt.Errorf("invalid component %q, want id=status", value)
+	}
+	s[id] = status
+	return nil
+}
+
+// apply sets the affected components of an incident request.
+func (s componentStatuses) apply(statuses *map[string]statuspage.ComponentStatus, ids *[]string) error {
+	for _, id := range slices.Sorted(maps.Keys(s)) {
+		value := s[id]
+		*ids = append(*ids, id)
+		if value == "" {
+			continue
+		}
+		status := statuspage.ComponentStatus(value)
+		if !status.Valid() {
+			return usagef("invalid component status %q", value)
+		}
+		if *statuses == nil {
+			*statuses = map[string]statuspage.ComponentStatus{}
+		}
+		(*statuses)[id] = status
+	}
+	return nil
+}
+
+// stringList is a repeatable string flag.
+type stringList []string
+
+func (l *stringList) String() string {
+	return strings.Join(*l, ",")
+}
+
+func (l *stringList) Set(value string) error {
+	*l = append(*l, value)
+	return nil
+}
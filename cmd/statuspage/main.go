@@ -0,0 +1,166 @@
+// Command statuspage operates Statuspage from the terminal.
+//
+// Usage:
+//
+//	statuspage <command> [arguments] [flags]
+//
+// The commands are:
+//
+//	pages list
+//	pages sync <file> [--dry-run]
+//	components list
+//	components show <component-id>
+//	components set-status <component-id> <status>
+//	incidents list [--unresolved]
+//	incidents show <incident-id>
+//	incidents open --name <name> [--status <status>] [--impact <impact>] [--body <text>] [--component <id>=<status>]...
+//	incidents update <incident-id> [--status <status>] [--body <text>] [--component <id>=<status>]...
+//	incidents resolve <incident-id> [--body <text>]
+//	maintenance schedule --name <name> --start <time> --end <time> [--body <text>] [--component <id>]... [--auto-transition]
+//
+// Flags may appear anywhere after the command. The global flags are:
+//
+//	-o, --output    output format: table, json or yaml (default table)
+//	--page          page id, defaults to STATUSPAGE_PAGE_ID or the config file
+//	--config        config file, defaults to STATUSPAGE_CONFIG or
+//	                statuspage/config.json in the user config directory
+//	--timeout       time limit of the command (default 30s)
+//
+// The API token is read from STATUSPAGE_TOKEN or, if that is unset, from the
+// config file, a JSON object like {"token": "...", "page_id": "..."}.
+// STATUSPAGE_BASE_URL overrides the API endpoint.
+//
+// pages sync reads the desired state of the page from a YAML or JSON file in
+// the format of the pagesync package, prints the changes needed to reach it
+// and makes them unless --dry-run is given.
+//
+// The exit status is 0 on success, 2 on usage errors and 1 on any other
+// error, including errors returned by the Statuspage API.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"strings"
+	"time"
+)
+
+const (
+	exitOK    = 0
+	exitError = 1
+	exitUsage = 2
+)
+
+const defaultTimeout = 30 * time.Second
+
+// usageError is an error caused by invalid arguments or flags.
+type usageError struct {
+	msg string
+}
+
+func (e *usageError) Error() string {
+	return e.msg
+}
+
+func usagef(format string, args ...interface{}) error {
+	return &usageError{fmt.Sprintf(format, args...)}
+}
+
+// command is a subcommand such as "components set-status".
+type command struct {
+	name  string
+	usage string
+	run   func(c *cli, ctx context.Context, args []string) error
+}
+
+func (c *command) String() string {
+	return strings.TrimSpace(c.name + " " + c.usage)
+}
+
+var commands = []command{
+	{"pages list", "", (*cli).listPages},
+	{"pages sync", "<file> [--dry-run]", (*cli).syncPage},
+	{"components list", "", (*cli).listComponents},
+	{"components show", "<component-id>", (*cli).showComponent},
+	{"components set-status", "<component-id> <status>", (*cli).setComponentStatus},
+	{"incidents list", "[--unresolved]", (*cli).listIncidents},
+	{"incidents show", "<incident-id>", (*cli).showIncident},
+	{"incidents open", "--name <name> [--status <status>] [--impact <impact>] [--body <text>] [--component <id>=<status>]...", (*cli).openIncident},
+	{"incidents update", "<incident-id> [--status <status>] [--body <text>] [--component <id>=<status>]...", (*cli).updateIncident},
+	{"incidents resolve", "<incident-id> [--body <text>]", (*cli).resolveIncident},
+	{"maintenance schedule", "--name <name> --start <time> --end <time> [--body <text>] [--component <id>]... [--auto-transition]", (*cli).scheduleMaintenance},
+}
+
+func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	code := run(ctx, os.Args[1:], os.Stdout, os.Stderr, os.Getenv)
+	stop()
+	os.Exit(code)
+}
+
+// run executes the command line args and returns the exit status.
+func run(ctx context.Context, args []string, stdout, stderr io.Writer, getenv func(string) string) int {
+	if len(args) == 0 || args[0] == "help" || args[0] == "-h" || args[0] == "--help" {
+		printUsage(stdout)
+		return exitOK
+	}
+
+	cmd, rest := findCommand(args)
+	if cmd == nil {
+		fmt.Fprintf(stderr, "statuspage: unknown command %q\n", strings.Join(args[:min(len(args), 2)], " "))
+		printUsage(stderr)
+		return exitUsage
+	}
+
+	c := &cli{name: cmd.name, usage: cmd.usage, stdout: stdout, stderr: stderr, getenv: getenv}
+	err := cmd.run(c, ctx, rest)
+	if err == nil {
+		return exitOK
+	}
+	if errors.Is(err, flag.ErrHelp) {
+		return exitOK
+	}
+
+	fmt.Fprintf(stderr, "statuspage: %v\n", err)
+	var usageErr *usageError
+	if errors.As(err, &usageErr) {
+		fmt.Fprintf(stderr, "usage: statuspage %s\n", cmd)
+		return exitUsage
+	}
+	return exitError
+}
+
+// findCommand returns the command named by the first two args and the
+// remaining args, or nil if there is no such command.
+func findCommand(args []string) (*command, []string) {
+	if len(args) < 2 {
+		return nil, nil
+	}
+	name := args[0] + " " + args[1]
+	for i := range commands {
+		if commands[i].name == name {
+			return &commands[i], args[2:]
+		}
+	}
+	return nil, nil
+}
+
+func printUsage(w io.Writer) {
+	fmt.Fprintln(w, "usage: statuspage <command> [arguments] [flags]")
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "commands:")
+	for _, cmd := range commands {
+		fmt.Fprintf(w, "  %s\n", &cmd)
+	}
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "flags:")
+	flags := flag.NewFlagSet("statuspage", flag.ContinueOnError)
+	(&cli{}).globalFlags(flags)
+	flags.SetOutput(w)
+	flags.PrintDefaults()
+}
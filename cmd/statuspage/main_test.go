@@ -0,0 +1,242 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/nagelflorian/statuspage-go"
+	"github.com/nagelflorian/statuspage-go/statuspagetest"
+)
+
+type testEnv struct {
+	server *statuspagetest.Server
+	pageID string
+	env    map[string]string
+}
+
+func newTestEnv(t *testing.T) *testEnv {
+	t.Helper()
+	server := statuspagetest.NewServer()
+	t.Cleanup(server.Close)
+
+	name := "Example"
+	pageID := server.AddPage(statuspage.Page{Name: &name})
+	config := filepath.Join(t.TempDir(), "config.json")
+	if err := os.WriteFile(config, []byte("{}"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return &testEnv{
+		server: server,
+		pageID: pageID,
+		env: map[string]string{
+			"STATUSPAGE_TOKEN":    statuspagetest.DefaultToken,
+			"STATUSPAGE_PAGE_ID":  pageID,
+			"STATUSPAGE_BASE_URL": server.URL,
+			"STATUSPAGE_CONFIG":   config,
+		},
+	}
+}
+
+// run runs the command line args and returns its exit status, stdout and
+// stderr.
+func (e *testEnv) run(args ...string) (int, string, string) {
+	var stdout, stderr bytes.Buffer
+	code := run(context.Background(), args, &stdout, &stderr, func(key string) string {
+		return e.env[key]
+	})
+	return code, stdout.String(), stderr.String()
+}
+
+func TestRun_components(t *testing.T) {
+	e := newTestEnv(t)
+	apiName := "API"
+	componentID := e.server.AddComponent(e.pageID, statuspage.Component{Name: &apiName})
+
+	code, stdout, stderr := e.run("components", "set-status", componentID, "major_outage", "-o", "json")
+	if code != exitOK {
+		t.Fatalf("components set-status exited with %d: %s", code, stderr)
+	}
+	var component statuspage.Component
+	if err := json.Unmarshal([]byte(stdout), &component); err != nil {
+		t.Fatalf("components set-status printed invalid JSON: %v", err)
+	}
+	if *component.Status != "major_outage" {
+		t.Errorf("components set-status returned status %q, want %q", *component.Status, "major_outage")
+	}
+
+	code, stdout, stderr = e.run("components", "list")
+	if code != exitOK {
+		t.Fatalf("components list exited with %d: %s", code, stderr)
+	}
+	lines := strings.Split(strings.TrimSpace(stdout), "\n")
+	if len(lines) != 2 || !strings.HasPrefix(lines[0], "ID") || !strings.Contains(lines[1], "major_outage") {
+		t.Errorf("components list printed %q", stdout)
+	}
+
+	code, stdout, stderr = e.run("components", "show", componentID, "--output", "yaml")
+	if code != exitOK {
+		t.Fatalf("components show exited with %d: %s", code, stderr)
+	}
+	if !strings.Contains(stdout, "\nname: API\n") || !strings.Contains(stdout, "\nstatus: major_outage\n") {
+		t.Errorf("components show printed %q", stdout)
+	}
+}
+
+func TestRun_incidents(t *testing.T) {
+	e := newTestEnv(t)
+	componentID := e.server.AddComponent(e.pageID, statuspage.Component{})
+
+	code, stdout, stderr := e.run("incidents", "open", "--name", "Database outage",
+		"--body", "We are investigating.", "--component", componentID+"=partial_outage", "-o", "json")
+	if code != exitOK {
+		t.Fatalf("incidents open exited with %d: %s", code, stderr)
+	}
+	var incident statuspage.Incident
+	if err := json.Unmarshal([]byte(stdout), &incident); err != nil {
+		t.Fatalf("incidents open printed invalid JSON: %v", err)
+	}
+
+	code, _, stderr = e.run("incidents", "update", *incident.ID, "--status", "monitoring", "--body", "Fix deployed.")
+	if code != exitOK {
+		t.Fatalf("incidents update exited with %d: %s", code, stderr)
+	}
+
+	code, stdout, _ = e.run("incidents", "list", "--unresolved")
+	if code != exitOK || !strings.Contains(stdout, "monitoring") {
+		t.Errorf("incidents list --unresolved exited with %d and printed %q", code, stdout)
+	}
+
+	code, _, stderr = e.run("incidents", "resolve", *incident.ID, "--body", "Resolved.")
+	if code != exitOK {
+		t.Fatalf("incidents resolve exited with %d: %s", code, stderr)
+	}
+
+	code, stdout, _ = e.run("incidents", "list", "--unresolved")
+	if lines := strings.Split(strings.TrimSpace(stdout), "\n"); code != exitOK || len(lines) != 1 {
+		t.Errorf("incidents list --unresolved exited with %d and printed %q, want header only", code, stdout)
+	}
+}
+
+func TestRun_scheduleMaintenance(t *testing.T) {
+	e := newTestEnv(t)
+	componentID := e.server.AddComponent(e.pageID, statuspage.Component{})
+
+	code, stdout, stderr := e.run("maintenance", "schedule", "--name", "Database upgrade",
+		"--start", "2030-01-02T15:00:00Z", "--end", "2030-01-02T17:00:00Z", "--component", componentID)
+	if code != exitOK {
+		t.Fatalf("maintenance schedule exited with %d: %s", code, stderr)
+	}
+	if !strings.Contains(stdout, "scheduled") || !strings.Contains(stdout, "maintenance") {
+		t.Errorf("maintenance schedule printed %q", stdout)
+	}
+}
+
+func TestRun_syncPage(t *testing.T) {
+	e := newTestEnv(t)
+	legacyName := "Legacy"
+	legacyID := e.server.AddComponent(e.pageID, statuspage.Component{Name: &legacyName})
+	path := filepath.Join(t.TempDir(), "page.yaml")
+	config := `page:
+  name: Example
+  time_zone: UTC
+component_groups:
+- name: Backend
+components:
+- name: API
+  group: Backend
+- name: Database
+  group: Backend
+`
+	if err := os.WriteFile(path, []byte(config), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	code, stdout, stderr := e.run("pages", "sync", path, "--dry-run")
+	if code != exitOK {
+		t.Fatalf("pages sync --dry-run exited with %d: %s", code, stderr)
+	}
+	if !strings.Contains(stdout, "Plan: 3 to create, 1 to update, 1 to delete.") {
+		t.Errorf("pages sync --dry-run printed %q", stdout)
+	}
+	if e.server.Requests() != 3 {
+		t.Errorf("pages sync --dry-run made %d requests, want 3", e.server.Requests())
+	}
+
+	code, stdout, stderr = e.run("pages", "sync", path)
+	if code != exitOK {
+		t.Fatalf("pages sync exited with %d: %s", code, stderr)
+	}
+	if !strings.Contains(stdout, `created component_group "Backend"`) || !strings.Contains(stdout, `deleted component "Legacy"`) {
+		t.Errorf("pages sync printed %q", stdout)
+	}
+	if code, _, _ := e.run("components", "show", legacyID); code != exitError {
+		t.Errorf("components show of the deleted component exited with %d, want %d", code, exitError)
+	}
+
+	code, stdout, stderr = e.run("pages", "sync", path)
+	if code != exitOK || stdout != "No changes.\n" {
+		t.Errorf("pages sync of a synced page exited with %d and printed %q: %s", code, stdout, stderr)
+	}
+}
+
+func TestRun_config(t *testing.T) {
+	e := newTestEnv(t)
+	path := filepath.Join(t.TempDir(), "config.json")
+	config := `{"token": "` + statuspagetest.DefaultToken + `", "page_id": "` + e.pageID + `"}`
+	if err := os.WriteFile(path, []byte(config), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	delete(e.env, "STATUSPAGE_TOKEN")
+	delete(e.env, "STATUSPAGE_PAGE_ID")
+
+	if code, _, stderr := e.run("components", "list", "--config", path); code != exitOK {
+		t.Errorf("components list exited with %d: %s", code, stderr)
+	}
+
+	if code, _, stderr := e.run("components", "list"); code != exitUsage || !strings.Contains(stderr, "no page") {
+		t.Errorf("components list without page exited with %d: %s", code, stderr)
+	}
+
+	e.env["STATUSPAGE_CONFIG"] = filepath.Join(t.TempDir(), "missing.json")
+	if code, _, stderr := e.run("components", "list"); code != exitError || !strings.Contains(stderr, "reading config") {
+		t.Errorf("components list with missing config exited with %d: %s", code, stderr)
+	}
+}
+
+func TestRun_errors(t *testing.T) {
+	e := newTestEnv(t)
+
+	tests := []struct {
+		args []string
+		want int
+	}{
+		{[]string{"components", "show", "unknown"}, exitError},
+		{[]string{"components", "set-status", "c", "broken"}, exitUsage},
+		{[]string{"components", "show"}, exitUsage},
+		{[]string{"components", "list", "--output", "xml"}, exitUsage},
+		{[]string{"components", "list", "--unknown"}, exitUsage},
+		{[]string{"incidents", "open"}, exitUsage},
+		{[]string{"maintenance", "schedule", "--name", "M", "--start", "tomorrow", "--end", "2030-01-02T17:00:00Z"}, exitUsage},
+		{[]string{"pages", "delete"}, exitUsage},
+		{[]string{"pages", "sync"}, exitUsage},
+		{[]string{"pages", "sync", "missing.yaml"}, exitError},
+		{[]string{"help"}, exitOK},
+		{[]string{"pages", "list", "-h"}, exitOK},
+	}
+
+	for _, tt := range tests {
+		if code, _, stderr := e.run(tt.args...); code != tt.want {
+			t.Errorf("statuspage %s exited with %d, want %d: %s", strings.Join(tt.args, " "), code, tt.want, stderr)
+		}
+	}
+
+	e.env["STATUSPAGE_TOKEN"] = "wrong"
+	if code, _, stderr := e.run("pages", "list"); code != exitError || !strings.Contains(stderr, "401") {
+		t.Errorf("pages list with wrong token exited with %d: %s", code, stderr)
+	}
+}
@@ -2,6 +2,8 @@ package statuspage
 
 import (
 	"context"
+	"iter"
+	"time"
 )
 
 // ComponentService handles communication with the page related methods
@@ -10,6 +12,19 @@ import (
 // Statuspage API docs: https://developer.statuspage.io/#tag/pages
 type ComponentService service
 
+// ComponentAPI is the interface implemented by ComponentService.
+type ComponentAPI interface {
+	GetComponent(ctx context.Context, pageID string, componentID string) (*Component, *Response, error)
+	ListComponents(ctx context.Context, pageID string, opts *ListOptions) (*[]Component, *Response, error)
+	All(ctx context.Context, pageID string) iter.Seq2[Component, error]
+	DeleteComponent(ctx context.Context, pageID string, componentID string) (*Response, error)
+	CreateComponent(ctx context.Context, pageID string, component CreateComponentParams) (*Component, *Response, error)
+	UpdateComponent(ctx context.Context, pageID string, componentID string, component UpdateComponentParams) (*Component, *Response, error)
+	GetComponentUptime(ctx context.Context, pageID string, componentID string, start, end time.Time) (*Uptime, *Response, error)
+}
+
+var _ ComponentAPI = (*ComponentService)(nil)
+
 // Component is the Statuspage API component representation
 type Component struct {
 	ID                 *string    `json:"id,omitempty"`
@@ -32,55 +47,97 @@ func (c Component) String() string {
 }
 
 // GetComponent returns component information for a given page and component id
-func (s *ComponentService) GetComponent(ctx context.Context, pageID string, componentID string) (*Component, error) {
+func (s *ComponentService) GetComponent(ctx context.Context, pageID string, componentID string) (*Component, *Response, error) {
 	path := "v1/pages/" + pageID + "/components/" + componentID
 	req, err := s.client.newRequest("GET", path, nil)
 
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	var component Component
-	_, err = s.client.do(ctx, req, &component)
+	resp, err := s.client.do(ctx, req, &component)
 
-	return &component, err
+	return &component, resp, err
 }
 
-// ListComponents returns a list of all components for a given page id
-func (s *ComponentService) ListComponents(ctx context.Context, pageID string) (*[]Component, error) {
-	path := "v1/pages/" + pageID + "/components"
+// ListComponents returns a list of components for a given page id
+func (s *ComponentService) ListComponents(ctx context.Context, pageID string, opts *ListOptions) (*[]Component, *Response, error) {
+	path, err := addOptions("v1/pages/"+pageID+"/components", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	req, err := s.client.newRequest("GET", path, nil)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	var components []Component
-	_, err = s.client.do(ctx, req, &components)
+	resp, err := s.client.do(ctx, req, &components)
 
-	return &components, err
+	return &components, resp, err
+}
+
+// All returns an iterator over all components for a given page id, fetching
+// further result pages as needed
+func (s *ComponentService) All(ctx context.Context, pageID string) iter.Seq2[Component, error] {
+	return allPages(ctx, func(ctx context.Context, opts *ListOptions) (*[]Component, *Response, error) {
+		return s.ListComponents(ctx, pageID, opts)
+	})
 }
 
 // DeleteComponent deletes a component for a given page and component id
-func (s *ComponentService) DeleteComponent(ctx context.Context, pageID string, componentID string) error {
+func (s *ComponentService) DeleteComponent(ctx context.Context, pageID string, componentID string) (*Response, error) {
 	path := "v1/pages/" + pageID + "/components/" + componentID
 	req, err := s.client.newRequest("DELETE", path, nil)
 	if err != nil {
-		return err
+		return nil, err
+	}
+
+	return s.client.do(ctx, req, nil)
+}
+
+// CreateComponentParams are the parameters that can be set using the create component API endpoint
+type CreateComponentParams struct {
+	Description        string          `json:"description,omitempty"`
+	Status             ComponentStatus `json:"status,omitempty"`
+	Name               string          `json:"name,omitempty"`
+	OnlyShowIfDegraded bool            `json:"only_show_if_degraded,omitempty"`
+	GroupID            string          `json:"group_id,omitempty"`
+	Showcase           bool            `json:"showcase,omitempty"`
+	StartDate          *Timestamp      `json:"start_date,omitempty"`
+}
+
+// CreateComponentRequestBody is the create component request body representation
+type CreateComponentRequestBody struct {
+	Component CreateComponentParams `json:"component"`
+}
+
+// CreateComponent creates a component for a given page id
+func (s *ComponentService) CreateComponent(ctx context.Context, pageID string, component CreateComponentParams) (*Component, *Response, error) {
+	path := "v1/pages/" + pageID + "/components"
+	payload := CreateComponentRequestBody{Component: component}
+	req, err := s.client.newRequest("POST", path, payload)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	_, err = s.client.do(ctx, req, nil)
-	return err
+	var createdComponent Component
+	resp, err := s.client.do(ctx, req, &createdComponent)
+
+	return &createdComponent, resp, err
 }
 
 // UpdateComponentParams are the parameters that can be changed using the update component API endpoint
 type UpdateComponentParams struct {
-	Description        string    `json:"description,omitempty"`
-	Status             string    `json:"status,omitempty"`
-	Name               string    `json:"name,omitempty"`
-	OnlyShowIfDegraded bool      `json:"only_show_if_degraded,omitempty"`
-	GroupID            string    `json:"group_id,omitempty"`
-	Showcase           bool      `json:"showcase,omitempty"`
-	StartDate          Timestamp `json:"start_date,omitempty"`
+	Description        string          `json:"description,omitempty"`
+	Status             ComponentStatus `json:"status,omitempty"`
+	Name               string          `json:"name,omitempty"`
+	OnlyShowIfDegraded bool            `json:"only_show_if_degraded,omitempty"`
+	GroupID            string          `json:"group_id,omitempty"`
+	Showcase           bool            `json:"showcase,omitempty"`
+	StartDate          *Timestamp      `json:"start_date,omitempty"`
 }
 
 // UpdateComponentRequestBody is the update component request body representation
@@ -89,16 +146,36 @@ type UpdateComponentRequestBody struct {
 }
 
 // UpdateComponent updates a component for a given page and component id
-func (s *ComponentService) UpdateComponent(ctx context.Context, pageID string, componentID string, component UpdateComponentParams) (*Component, error) {
+func (s *ComponentService) UpdateComponent(ctx context.Context, pageID string, componentID string, component UpdateComponentParams) (*Component, *Response, error) {
 	path := "v1/pages/" + pageID + "/components/" + componentID
 	payload := UpdateComponentRequestBody{Component: component}
 	req, err := s.client.newRequest("PATCH", path, payload)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	var updatedComponent Component
-	_, err = s.client.do(ctx, req, &updatedComponent)
+	resp, err := s.client.do(ctx, req, &updatedComponent)
+
+	return &updatedComponent, resp, err
+}
+
+// GetComponentUptime returns the uptime of a component for a given page and
+// component id between start and end. Zero times let the API pick its default
+// range.
+func (s *ComponentService) GetComponentUptime(ctx context.Context, pageID string, componentID string, start, end time.Time) (*Uptime, *Response, error) {
+	path, err := addOptions("v1/pages/"+pageID+"/components/"+componentID+"/uptime", newUptimeOptions(start, end))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.newRequest("GET", path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var uptime Uptime
+	resp, err := s.client.do(ctx, req, &uptime)
 
-	return &updatedComponent, err
+	return &uptime, resp, err
 }
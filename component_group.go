@@ -0,0 +1,172 @@
+package statuspage
+
+import (
+	"context"
+	"iter"
+	"time"
+)
+
+// ComponentGroupService handles communication with the component group
+// related methods of the Statuspage API.
+//
+// Statuspage API docs: https://developer.statuspage.io/#tag/component-groups
+type ComponentGroupService service
+
+// ComponentGroupAPI is the interface implemented by ComponentGroupService.
+type ComponentGroupAPI interface {
+	GetComponentGroup(ctx context.Context, pageID string, groupID string) (*ComponentGroup, *Response, error)
+	ListComponentGroups(ctx context.Context, pageID string, opts *ListOptions) (*[]ComponentGroup, *Response, error)
+	All(ctx context.Context, pageID string) iter.Seq2[ComponentGroup, error]
+	CreateComponentGroup(ctx context.Context, pageID string, group CreateComponentGroupParams) (*ComponentGroup, *Response, error)
+	UpdateComponentGroup(ctx context.Context, pageID string, groupID string, group UpdateComponentGroupParams) (*ComponentGroup, *Response, error)
+	DeleteComponentGroup(ctx context.Context, pageID string, groupID string) (*Response, error)
+	GetComponentGroupUptime(ctx context.Context, pageID string, groupID string, start, end time.Time) (*Uptime, *Response, error)
+}
+
+var _ ComponentGroupAPI = (*ComponentGroupService)(nil)
+
+// ComponentGroup is the Statuspage API component group representation
+type ComponentGroup struct {
+	ID          *string    `json:"id,omitempty"`
+	PageID      *string    `json:"page_id,omitempty"`
+	Name        *string    `json:"name,omitempty"`
+	Description *string    `json:"description,omitempty"`
+	Components  []string   `json:"components,omitempty"`
+	Position    *int32     `json:"position,omitempty"`
+	CreatedAt   *Timestamp `json:"created_at,omitempty"`
+	UpdatedAt   *Timestamp `json:"updated_at,omitempty"`
+}
+
+func (g ComponentGroup) String() string {
+	return Stringify(g)
+}
+
+// GetComponentGroup returns component group information for a given page and group id
+func (s *ComponentGroupService) GetComponentGroup(ctx context.Context, pageID string, groupID string) (*ComponentGroup, *Response, error) {
+	path := "v1/pages/" + pageID + "/component-groups/" + groupID
+	req, err := s.client.newRequest("GET", path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var group ComponentGroup
+	resp, err := s.client.do(ctx, req, &group)
+
+	return &group, resp, err
+}
+
+// ListComponentGroups returns a list of component groups for a given page id
+func (s *ComponentGroupService) ListComponentGroups(ctx context.Context, pageID string, opts *ListOptions) (*[]ComponentGroup, *Response, error) {
+	path, err := addOptions("v1/pages/"+pageID+"/component-groups", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.newRequest("GET", path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var groups []ComponentGroup
+	resp, err := s.client.do(ctx, req, &groups)
+
+	return &groups, resp, err
+}
+
+// All returns an iterator over all component groups for a given page id,
+// fetching further result pages as needed
+func (s *ComponentGroupService) All(ctx context.Context, pageID string) iter.Seq2[ComponentGroup, error] {
+	return allPages(ctx, func(ctx context.Context, opts *ListOptions) (*[]ComponentGroup, *Response, error) {
+		return s.ListComponentGroups(ctx, pageID, opts)
+	})
+}
+
+// CreateComponentGroupParams are the parameters that can be set using the create component group API endpoint
+type CreateComponentGroupParams struct {
+	Name        string   `json:"name,omitempty"`
+	Description string   `json:"-"` // sent next to the component group
+	Components  []string `json:"components,omitempty"`
+}
+
+// CreateComponentGroupRequestBody is the create component group request body representation.
+// The API expects the description next to the component group rather than inside of it.
+type CreateComponentGroupRequestBody struct {
+	Description    string                     `json:"description,omitempty"`
+	ComponentGroup CreateComponentGroupParams `json:"component_group"`
+}
+
+// CreateComponentGroup creates a component group for a given page id
+func (s *ComponentGroupService) CreateComponentGroup(ctx context.Context, pageID string, group CreateComponentGroupParams) (*ComponentGroup, *Response, error) {
+	path := "v1/pages/" + pageID + "/component-groups"
+	payload := CreateComponentGroupRequestBody{Description: group.Description, ComponentGroup: group}
+	req, err := s.client.newRequest("POST", path, payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var createdGroup ComponentGroup
+	resp, err := s.client.do(ctx, req, &createdGroup)
+
+	return &createdGroup, resp, err
+}
+
+// UpdateComponentGroupParams are the parameters that can be changed using the update component group API endpoint
+type UpdateComponentGroupParams struct {
+	Name        string   `json:"name,omitempty"`
+	Description string   `json:"-"` // sent next to the component group
+	Components  []string `json:"components,omitempty"`
+}
+
+// UpdateComponentGroupRequestBody is the update component group request body representation.
+// The API expects the description next to the component group rather than inside of it.
+type UpdateComponentGroupRequestBody struct {
+	Description    string                     `json:"description,omitempty"`
+	ComponentGroup UpdateComponentGroupParams `json:"component_group"`
+}
+
+// UpdateComponentGroup updates a component group for a given page and group id
+func (s *ComponentGroupService) UpdateComponentGroup(ctx context.Context, pageID string, groupID string, group UpdateComponentGroupParams) (*ComponentGroup, *Response, error) {
+	path := "v1/pages/" + pageID + "/component-groups/" + groupID
+	payload := UpdateComponentGroupRequestBody{Description: group.Description, ComponentGroup: group}
+	req, err := s.client.newRequest("PATCH", path, payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var updatedGroup ComponentGroup
+	resp, err := s.client.do(ctx, req, &updatedGroup)
+
+	return &updatedGroup, resp, err
+}
+
+// DeleteComponentGroup deletes a component group for a given page and group id.
+// The components of the group are kept.
+func (s *ComponentGroupService) DeleteComponentGroup(ctx context.Context, pageID string, groupID string) (*Response, error) {
+	path := "v1/pages/" + pageID + "/component-groups/" + groupID
+	req, err := s.client.newRequest("DELETE", path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.do(ctx, req, nil)
+}
+
+// GetComponentGroupUptime returns the uptime of a component group for a given
+// page and group id between start and end. Zero times let the API pick its
+// default range.
+func (s *ComponentGroupService) GetComponentGroupUptime(ctx context.Context, pageID string, groupID string, start, end time.Time) (*Uptime, *Response, error) {
+	path, err := addOptions("v1/pages/"+pageID+"/component-groups/"+groupID+"/uptime", newUptimeOptions(start, end))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.newRequest("GET", path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var uptime Uptime
+	resp, err := s.client.do(ctx, req, &uptime)
+
+	return &uptime, resp, err
+}
@@ -0,0 +1,215 @@
+package statuspage
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestComponentGroup_marshall(t *testing.T) {
+	testJSONMarshal(t, &ComponentGroup{}, "{}")
+
+	u := &ComponentGroup{
+		ID:          String("a"),
+		PageID:      String("b"),
+		Name:        String("c"),
+		Description: String("d"),
+		Components:  []string{"e", "f"},
+		Position:    Int32(1),
+		CreatedAt:   &Timestamp{referenceTime},
+		UpdatedAt:   &Timestamp{referenceTime},
+	}
+	want := `{
+		"id": "a",
+		"page_id": "b",
+		"name": "c",
+		"description": "d",
+		"components": ["e", "f"],
+		"position": 1,
+		"created_at": "2006-01-02T15:04:05Z",
+		"updated_at": "2006-01-02T15:04:05Z"
+	}`
+	testJSONMarshal(t, u, want)
+}
+
+func TestComponentGroupService_GetComponentGroup(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v1/pages/1/component-groups/2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id":"2"}`)
+	})
+
+	group, _, err := client.ComponentGroup.GetComponentGroup(context.Background(), "1", "2")
+	if err != nil {
+		t.Errorf("ComponentGroupService.GetComponentGroup returned error: %v", err)
+	}
+
+	want := &ComponentGroup{ID: String("2")}
+	if !reflect.DeepEqual(group, want) {
+		t.Errorf("ComponentGroupService.GetComponentGroup returned %+v, want %+v", group, want)
+	}
+}
+
+func TestComponentGroupService_ListComponentGroups(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v1/pages/1/component-groups", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[{"id":"1"}, {"id":"2"}]`)
+	})
+
+	groups, _, err := client.ComponentGroup.ListComponentGroups(context.Background(), "1", nil)
+	if err != nil {
+		t.Errorf("ComponentGroupService.ListComponentGroups returned error: %v", err)
+	}
+
+	want := &[]ComponentGroup{
+		{ID: String("1")},
+		{ID: String("2")},
+	}
+	if !reflect.DeepEqual(groups, want) {
+		t.Errorf("ComponentGroupService.ListComponentGroups returned %+v, want %+v", groups, want)
+	}
+}
+
+func TestComponentGroupService_CreateComponentGroup(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	input := CreateComponentGroupParams{
+		Name:        "a",
+		Description: "b",
+		Components:  []string{"c"},
+	}
+
+	mux.HandleFunc("/v1/pages/1/component-groups", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+
+		body, _ := io.ReadAll(r.Body)
+		if got, want := string(body), `{"description":"b","component_group":{"name":"a","components":["c"]}}`+"\n"; got != want {
+			t.Errorf("Request body = %s, want %s", got, want)
+		}
+
+		fmt.Fprint(w, `{"id":"2"}`)
+	})
+
+	group, _, err := client.ComponentGroup.CreateComponentGroup(context.Background(), "1", input)
+	if err != nil {
+		t.Errorf("ComponentGroupService.CreateComponentGroup returned error: %v", err)
+	}
+
+	want := &ComponentGroup{ID: String("2")}
+	if !reflect.DeepEqual(group, want) {
+		t.Errorf("ComponentGroupService.CreateComponentGroup returned %+v, want %+v", group, want)
+	}
+}
+
+func TestComponentGroupService_UpdateComponentGroup(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	input := UpdateComponentGroupParams{Name: "a", Description: "b"}
+
+	mux.HandleFunc("/v1/pages/1/component-groups/2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+
+		body, _ := io.ReadAll(r.Body)
+		if got, want := string(body), `{"description":"b","component_group":{"name":"a"}}`+"\n"; got != want {
+			t.Errorf("Request body = %s, want %s", got, want)
+		}
+
+		fmt.Fprint(w, `{"id":"2", "name":"a"}`)
+	})
+
+	group, _, err := client.ComponentGroup.UpdateComponentGroup(context.Background(), "1", "2", input)
+	if err != nil {
+		t.Errorf("ComponentGroupService.UpdateComponentGroup returned error: %v", err)
+	}
+
+	want := &ComponentGroup{ID: String("2"), Name: String("a")}
+	if !reflect.DeepEqual(group, want) {
+		t.Errorf("ComponentGroupService.UpdateComponentGroup returned %+v, want %+v", group, want)
+	}
+}
+
+func TestComponentGroupService_DeleteComponentGroup(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v1/pages/1/component-groups/2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		fmt.Fprint(w, `{}`)
+	})
+
+	_, err := client.ComponentGroup.DeleteComponentGroup(context.Background(), "1", "2")
+	if err != nil {
+		t.Errorf("ComponentGroupService.DeleteComponentGroup returned error: %v", err)
+	}
+}
+
+func TestComponentGroupService_GetComponentGroupUptime(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v1/pages/1/component-groups/2/uptime", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if got, want := r.URL.RawQuery, "end=2006-02-01&start=2006-01-02"; got != want {
+			t.Errorf("Query = %v, want %v", got, want)
+		}
+		fmt.Fprint(w, `{
+			"id": "2",
+			"range_start": "2006-01-02",
+			"range_end": "2006-02-01",
+			"uptime_percentage": 99.5,
+			"major_outage": 60,
+			"partial_outage": 120,
+			"warnings": ["a"],
+			"related_events": [{"id": "b", "name": "c"}]
+		}`)
+	})
+
+	end := referenceTime.AddDate(0, 0, 30)
+	uptime, _, err := client.ComponentGroup.GetComponentGroupUptime(context.Background(), "1", "2", referenceTime, end)
+	if err != nil {
+		t.Errorf("ComponentGroupService.GetComponentGroupUptime returned error: %v", err)
+	}
+
+	percentage := 99.5
+	want := &Uptime{
+		ID:               String("2"),
+		RangeStart:       &Timestamp{time.Date(2006, time.January, 2, 0, 0, 0, 0, time.UTC)},
+		RangeEnd:         &Timestamp{time.Date(2006, time.February, 1, 0, 0, 0, 0, time.UTC)},
+		UptimePercentage: &percentage,
+		MajorOutage:      Int64(60),
+		PartialOutage:    Int64(120),
+		Warnings:         []string{"a"},
+		RelatedEvents:    []UptimeEvent{{ID: String("b"), Name: String("c")}},
+	}
+	if !reflect.DeepEqual(uptime, want) {
+		t.Errorf("ComponentGroupService.GetComponentGroupUptime returned %+v, want %+v", uptime, want)
+	}
+}
+
+func TestComponentGroupService_GetComponentGroupUptime_defaultRange(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v1/pages/1/component-groups/2/uptime", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.RawQuery != "" {
+			t.Errorf("Query = %v, want none", r.URL.RawQuery)
+		}
+		fmt.Fprint(w, `{"id":"2"}`)
+	})
+
+	_, _, err := client.ComponentGroup.GetComponentGroupUptime(context.Background(), "1", "2", time.Time{}, time.Time{})
+	if err != nil {
+		t.Errorf("ComponentGroupService.GetComponentGroupUptime returned error: %v", err)
+	}
+}
@@ -2,10 +2,13 @@ package statuspage
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"reflect"
 	"testing"
+	"time"
 )
 
 func TestComponent_marshall(t *testing.T) {
@@ -53,7 +56,7 @@ func TestComponentService_GetComponent(t *testing.T) {
 		fmt.Fprint(w, `{"id":"2"}`)
 	})
 
-	component, err := client.Component.GetComponent(context.Background(), "1", "2")
+	component, _, err := client.Component.GetComponent(context.Background(), "1", "2")
 	if err != nil {
 		t.Errorf("ComponentService.GetComponent returned error: %v", err)
 	}
@@ -73,7 +76,7 @@ func TestComponentService_ListComponent(t *testing.T) {
 		fmt.Fprint(w, `[{"id":"1"}, {"id":"2"}]`)
 	})
 
-	components, err := client.Component.ListComponents(context.Background(), "1")
+	components, _, err := client.Component.ListComponents(context.Background(), "1", nil)
 	if err != nil {
 		t.Errorf("ComponentService.ListComponents returned error: %v", err)
 	}
@@ -96,7 +99,7 @@ func TestComponentService_DeleteComponent(t *testing.T) {
 		fmt.Fprint(w, `{}`)
 	})
 
-	err := client.Component.DeleteComponent(context.Background(), "1", "2")
+	_, err := client.Component.DeleteComponent(context.Background(), "1", "2")
 	if err != nil {
 		t.Errorf("ComponentService.DeleteComponent returned error: %v", err)
 	}
@@ -108,13 +111,17 @@ func TestComponentService_UpdateComponent(t *testing.T) {
 
 	mux.HandleFunc("/v1/pages/1/components/2", func(w http.ResponseWriter, r *http.Request) {
 		testMethod(t, r, "PATCH")
+		body, _ := io.ReadAll(r.Body)
+		if got, want := string(body), `{"component":{"status":"major_outage"}}`+"\n"; got != want {
+			t.Errorf("Request body = %s, want %s", got, want)
+		}
 		fmt.Fprint(w, `{"id":"2", "status": "major_outage"}`)
 	})
 
 	componentParams := UpdateComponentParams{
 		Status: "major_outage",
 	}
-	updatedComponent, err := client.Component.UpdateComponent(context.Background(), "1", "2", componentParams)
+	updatedComponent, _, err := client.Component.UpdateComponent(context.Background(), "1", "2", componentParams)
 	if err != nil {
 		t.Errorf("ComponentService.UpdateComponent returned error: %v", err)
 	}
@@ -124,3 +131,99 @@ func TestComponentService_UpdateComponent(t *testing.T) {
 		t.Errorf("ComponentService.UpdateComponent returned %+v, want %+v", updatedComponent, want)
 	}
 }
+
+func TestComponentService_CreateComponent(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	input := CreateComponentParams{
+		Name:      "a",
+		Status:    "operational",
+		GroupID:   "b",
+		StartDate: &Timestamp{referenceTime},
+	}
+
+	mux.HandleFunc("/v1/pages/1/components", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+
+		v := &CreateComponentRequestBody{}
+		json.NewDecoder(r.Body).Decode(v)
+		if !reflect.DeepEqual(v.Component, input) {
+			t.Errorf("Request body = %+v, want %+v", v.Component, input)
+		}
+
+		fmt.Fprint(w, `{"id":"2", "name": "a"}`)
+	})
+
+	component, _, err := client.Component.CreateComponent(context.Background(), "1", input)
+	if err != nil {
+		t.Errorf("ComponentService.CreateComponent returned error: %v", err)
+	}
+
+	want := &Component{ID: String("2"), Name: String("a")}
+	if !reflect.DeepEqual(component, want) {
+		t.Errorf("ComponentService.CreateComponent returned %+v, want %+v", component, want)
+	}
+}
+
+func TestComponentService_GetComponentUptime(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v1/pages/1/components/2/uptime", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if got, want := r.URL.RawQuery, "end=2006-01-09&start=2006-01-02"; got != want {
+			t.Errorf("Query = %v, want %v", got, want)
+		}
+		fmt.Fprint(w, `{
+			"id": "2",
+			"name": "API",
+			"range_start": "2006-01-02",
+			"range_end": "2006-01-09",
+			"uptime_percentage": 99.9,
+			"major_outage": 30,
+			"partial_outage": 0,
+			"warnings": ["a"],
+			"related_events": [{"id": "b", "name": "c"}]
+		}`)
+	})
+
+	end := referenceTime.AddDate(0, 0, 7)
+	uptime, _, err := client.Component.GetComponentUptime(context.Background(), "1", "2", referenceTime, end)
+	if err != nil {
+		t.Errorf("ComponentService.GetComponentUptime returned error: %v", err)
+	}
+
+	percentage := 99.9
+	want := &Uptime{
+		ID:               String("2"),
+		Name:             String("API"),
+		RangeStart:       &Timestamp{time.Date(2006, time.January, 2, 0, 0, 0, 0, time.UTC)},
+		RangeEnd:         &Timestamp{time.Date(2006, time.January, 9, 0, 0, 0, 0, time.UTC)},
+		UptimePercentage: &percentage,
+		MajorOutage:      Int64(30),
+		PartialOutage:    Int64(0),
+		Warnings:         []string{"a"},
+		RelatedEvents:    []UptimeEvent{{ID: String("b"), Name: String("c")}},
+	}
+	if !reflect.DeepEqual(uptime, want) {
+		t.Errorf("ComponentService.GetComponentUptime returned %+v, want %+v", uptime, want)
+	}
+}
+
+func TestComponentService_GetComponentUptime_defaultRange(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v1/pages/1/components/2/uptime", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.RawQuery != "" {
+			t.Errorf("Query = %v, want none", r.URL.RawQuery)
+		}
+		fmt.Fprint(w, `{"id":"2"}`)
+	})
+
+	_, _, err := client.Component.GetComponentUptime(context.Background(), "1", "2", time.Time{}, time.Time{})
+	if err != nil {
+		t.Errorf("ComponentService.GetComponentUptime returned error: %v", err)
+	}
+}
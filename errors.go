@@ -0,0 +1,127 @@
+package statuspage
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// ErrorResponse reports an error caused by an API request. It is returned by
+// every service method when the Statuspage API responds with a 4xx or 5xx
+// status code.
+//
+// Statuspage API docs: https://developer.statuspage.io/#section/Errors
+type ErrorResponse struct {
+	Response   *http.Response // HTTP response that caused this error
+	StatusCode int            // HTTP status code of the response
+
+	// Message is the "message" field of the error body, if any.
+	Message string `json:"message,omitempty"`
+
+	// Errors holds the "error" field of the error body, which Statuspage
+	// sends either as a single string or as a list of validation messages.
+	Errors []string `json:"-"`
+
+	// Body is the raw response body, kept for bodies that are not JSON.
+	Body []byte `json:"-"`
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (r *ErrorResponse) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		Message string          `json:"message"`
+		Error   json.RawMessage `json:"error"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	r.Message = raw.Message
+	r.Errors = nil
+	if len(raw.Error) == 0 || string(raw.Error) == "null" {
+		return nil
+	}
+
+	var single string
+	if err := json.Unmarshal(raw.Error, &single); err == nil {
+		r.Errors = []string{single}
+		return nil
+	}
+
+	return json.Unmarshal(raw.Error, &r.Errors)
+}
+
+func (r *ErrorResponse) Error() string {
+	detail := r.Message
+	if len(r.Errors) > 0 {
+		if detail != "" {
+			detail += ": "
+		}
+		detail += strings.Join(r.Errors, ", ")
+	}
+	if detail == "" {
+		detail = strings.TrimSpace(string(r.Body))
+	}
+
+	if r.Response == nil || r.Response.Request == nil {
+		return fmt.Sprintf("%d %s", r.StatusCode, detail)
+	}
+
+	return fmt.Sprintf("%v %v: %d %v",
+		r.Response.Request.Method, r.Response.Request.URL, r.StatusCode, detail)
+}
+
+// checkResponse checks the API response for errors, and returns them if
+// present. A response is considered an error if it has a status code of 400
+// or above. body is the already read response body.
+func checkResponse(r *http.Response, body []byte) error {
+	if r.StatusCode < http.StatusBadRequest {
+		return nil
+	}
+
+	errorResponse := &ErrorResponse{Response: r, StatusCode: r.StatusCode, Body: body}
+	if len(body) > 0 {
+		// Bodies that aren't JSON are still surfaced through Body.
+		json.Unmarshal(body, errorResponse)
+	}
+
+	return errorResponse
+}
+
+// hasStatus reports whether err is, or wraps, an *ErrorResponse with the
+// given HTTP status code.
+func hasStatus(err error, code int) bool {
+	var errorResponse *ErrorResponse
+	return errors.As(err, &errorResponse) && errorResponse.StatusCode == code
+}
+
+// IsNotFound reports whether err was caused by a 404 Not Found response, or
+// is ErrTemplateNotFound.
+func IsNotFound(err error) bool {
+	return hasStatus(err, http.StatusNotFound) || errors.Is(err, ErrTemplateNotFound)
+}
+
+// IsUnauthorized reports whether err was caused by a 401 Unauthorized
+// response, typically due to a missing or invalid API token.
+func IsUnauthorized(err error) bool {
+	return hasStatus(err, http.StatusUnauthorized)
+}
+
+// IsForbidden reports whether err was caused by a 403 Forbidden response.
+func IsForbidden(err error) bool {
+	return hasStatus(err, http.StatusForbidden)
+}
+
+// IsUnprocessable reports whether err was caused by a 422 Unprocessable
+// Entity response, which Statuspage returns for validation errors.
+func IsUnprocessable(err error) bool {
+	return hasStatus(err, http.StatusUnprocessableEntity)
+}
+
+// IsRateLimited reports whether err was caused by a 429 Too Many Requests
+// response.
+func IsRateLimited(err error) bool {
+	return hasStatus(err, http.StatusTooManyRequests)
+}
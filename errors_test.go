@@ -0,0 +1,110 @@
+package statuspage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestErrorResponse_statusCodes(t *testing.T) {
+	tests := []struct {
+		status int
+		body   string
+		check  func(error) bool
+	}{
+		{http.StatusNotFound, `{"error":"Not found"}`, IsNotFound},
+		{http.StatusUnauthorized, `{"error":"Unauthorized"}`, IsUnauthorized},
+		{http.StatusForbidden, `{"error":"Forbidden"}`, IsForbidden},
+		{http.StatusUnprocessableEntity, `{"error":["Name can't be blank"]}`, IsUnprocessable},
+		{http.StatusTooManyRequests, `{"message":"Rate limit exceeded"}`, IsRateLimited},
+	}
+
+	for _, tt := range tests {
+		t.Run(http.StatusText(tt.status), func(t *testing.T) {
+			client, mux, _, teardown := setup()
+			defer teardown()
+
+			mux.HandleFunc("/v1/pages/1", func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tt.status)
+				fmt.Fprint(w, tt.body)
+			})
+
+			_, _, err := client.Page.GetPage(context.Background(), "1")
+			if err == nil {
+				t.Fatal("Expected error to be returned")
+			}
+			if !tt.check(err) {
+				t.Errorf("Expected status check to match error %v", err)
+			}
+			if IsNotFound(err) != (tt.status == http.StatusNotFound) {
+				t.Errorf("IsNotFound(%v) = %v", err, IsNotFound(err))
+			}
+
+			var errorResponse *ErrorResponse
+			if !errors.As(err, &errorResponse) {
+				t.Fatalf("Expected *ErrorResponse, got %T", err)
+			}
+			if errorResponse.StatusCode != tt.status {
+				t.Errorf("ErrorResponse.StatusCode = %d, want %d", errorResponse.StatusCode, tt.status)
+			}
+			if errorResponse.Response == nil {
+				t.Errorf("ErrorResponse.Response is nil")
+			}
+		})
+	}
+}
+
+func TestErrorResponse_body(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v1/pages/1/components/2", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		fmt.Fprint(w, `{"message":"Validation failed","error":["Name can't be blank","Status is invalid"]}`)
+	})
+
+	_, _, err := client.Component.UpdateComponent(context.Background(), "1", "2", UpdateComponentParams{})
+	errorResponse, ok := err.(*ErrorResponse)
+	if !ok {
+		t.Fatalf("Expected *ErrorResponse, got %T", err)
+	}
+
+	if want := "Validation failed"; errorResponse.Message != want {
+		t.Errorf("ErrorResponse.Message = %q, want %q", errorResponse.Message, want)
+	}
+	if want := []string{"Name can't be blank", "Status is invalid"}; !reflect.DeepEqual(errorResponse.Errors, want) {
+		t.Errorf("ErrorResponse.Errors = %q, want %q", errorResponse.Errors, want)
+	}
+}
+
+func TestErrorResponse_nonJSONBody(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v1/pages/1", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "Bad Gateway", http.StatusBadGateway)
+	})
+
+	_, _, err := client.Page.GetPage(context.Background(), "1")
+	errorResponse, ok := err.(*ErrorResponse)
+	if !ok {
+		t.Fatalf("Expected *ErrorResponse, got %T", err)
+	}
+
+	if got, want := string(errorResponse.Body), "Bad Gateway\n"; got != want {
+		t.Errorf("ErrorResponse.Body = %q, want %q", got, want)
+	}
+	if errorResponse.Error() == "" {
+		t.Errorf("ErrorResponse.Error returned empty string")
+	}
+}
+
+func TestErrorResponse_Error(t *testing.T) {
+	err := &ErrorResponse{StatusCode: http.StatusNotFound, Message: "m", Errors: []string{"a", "b"}}
+	if got, want := err.Error(), "404 m: a, b"; got != want {
+		t.Errorf("ErrorResponse.Error() = %q, want %q", got, want)
+	}
+}
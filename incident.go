@@ -0,0 +1,269 @@
+package statuspage
+
+import (
+	"context"
+	"iter"
+)
+
+// IncidentService handles communication with the incident related methods
+// of the Statuspage API.
+//
+// Statuspage API docs: https://developer.statuspage.io/#tag/incidents
+type IncidentService service
+
+// IncidentAPI is the interface implemented by IncidentService.
+type IncidentAPI interface {
+	CreateIncident(ctx context.Context, pageID string, incident CreateIncidentParams) (*Incident, *Response, error)
+	UpdateIncident(ctx context.Context, pageID string, incidentID string, incident UpdateIncidentParams) (*Incident, *Response, error)
+	ResolveIncident(ctx context.Context, pageID string, incidentID string, body string) (*Incident, *Response, error)
+	GetIncident(ctx context.Context, pageID string, incidentID string) (*Incident, *Response, error)
+	DeleteIncident(ctx context.Context, pageID string, incidentID string) (*Response, error)
+	ListIncidents(ctx context.Context, pageID string, opts *ListOptions) (*[]Incident, *Response, error)
+	ListUnresolvedIncidents(ctx context.Context, pageID string, opts *ListOptions) (*[]Incident, *Response, error)
+	ListUpcomingIncidents(ctx context.Context, pageID string, opts *ListOptions) (*[]Incident, *Response, error)
+	ListActiveMaintenanceIncidents(ctx context.Context, pageID string, opts *ListOptions) (*[]Incident, *Response, error)
+	ListScheduledIncidents(ctx context.Context, pageID string, opts *ListOptions) (*[]Incident, *Response, error)
+	All(ctx context.Context, pageID string) iter.Seq2[Incident, error]
+	ScheduleMaintenance(ctx context.Context, pageID string, window MaintenanceWindow) (*Incident, *Response, error)
+	CompleteMaintenance(ctx context.Context, pageID string, incidentID string, body string) (*Incident, *Response, error)
+	GetPostmortem(ctx context.Context, pageID string, incidentID string) (*Postmortem, *Response, error)
+	CreateOrUpdatePostmortem(ctx context.Context, pageID string, incidentID string, bodyDraft string) (*Postmortem, *Response, error)
+	PublishPostmortem(ctx context.Context, pageID string, incidentID string, params PublishPostmortemParams) (*Postmortem, *Response, error)
+	RevertPostmortem(ctx context.Context, pageID string, incidentID string) (*Postmortem, *Response, error)
+	CreateIncidentFromTemplate(ctx context.Context, pageID string, templateID string, overrides *IncidentTemplateOverrides) (*Incident, *Response, error)
+}
+
+var _ IncidentAPI = (*IncidentService)(nil)
+
+// AffectedComponent is the Statuspage API representation of a component
+// status change attached to an incident update
+type AffectedComponent struct {
+	Code      *string `json:"code,omitempty"`
+	Name      *string `json:"name,omitempty"`
+	OldStatus *string `json:"old_status,omitempty"`
+	NewStatus *string `json:"new_status,omitempty"`
+}
+
+// IncidentUpdate is the Statuspage API incident update representation
+type IncidentUpdate struct {
+	ID                   *string             `json:"id,omitempty"`
+	IncidentID           *string             `json:"incident_id,omitempty"`
+	AffectedComponents   []AffectedComponent `json:"affected_components,omitempty"`
+	Body                 *string             `json:"body,omitempty"`
+	CreatedAt            *Timestamp          `json:"created_at,omitempty"`
+	CustomTweet          *string             `json:"custom_tweet,omitempty"`
+	DeliverNotifications *bool               `json:"deliver_notifications,omitempty"`
+	DisplayAt            *Timestamp          `json:"display_at,omitempty"`
+	Status               *string             `json:"status,omitempty"`
+	TweetID              *string             `json:"tweet_id,omitempty"`
+	TwitterUpdatedAt     *Timestamp          `json:"twitter_updated_at,omitempty"`
+	UpdatedAt            *Timestamp          `json:"updated_at,omitempty"`
+	WantsTwitterUpdate   *bool               `json:"wants_twitter_update,omitempty"`
+}
+
+func (u IncidentUpdate) String() string {
+	return Stringify(u)
+}
+
+// Incident is the Statuspage API incident representation
+type Incident struct {
+	ID              *string          `json:"id,omitempty"`
+	PageID          *string          `json:"page_id,omitempty"`
+	Name            *string          `json:"name,omitempty"`
+	Status          *string          `json:"status,omitempty"`
+	Impact          *string          `json:"impact,omitempty"`
+	ImpactOverride  *string          `json:"impact_override,omitempty"`
+	Shortlink       *string          `json:"shortlink,omitempty"`
+	CreatedAt       *Timestamp       `json:"created_at,omitempty"`
+	UpdatedAt       *Timestamp       `json:"updated_at,omitempty"`
+	MonitoringAt    *Timestamp       `json:"monitoring_at,omitempty"`
+	ResolvedAt      *Timestamp       `json:"resolved_at,omitempty"`
+	Components      []Component      `json:"components,omitempty"`
+	IncidentUpdates []IncidentUpdate `json:"incident_updates,omitempty"`
+
+	// Scheduled maintenance fields, only set for incidents with the
+	// maintenance impact.
+	ScheduledFor                              *Timestamp `json:"scheduled_for,omitempty"`
+	ScheduledUntil                            *Timestamp `json:"scheduled_until,omitempty"`
+	ScheduledRemindPrior                      *bool      `json:"scheduled_remind_prior,omitempty"`
+	ScheduledRemindedAt                       *Timestamp `json:"scheduled_reminded_at,omitempty"`
+	ScheduledAutoInProgress                   *bool      `json:"scheduled_auto_in_progress,omitempty"`
+	ScheduledAutoCompleted                    *bool      `json:"scheduled_auto_completed,omitempty"`
+	AutoTransitionToMaintenanceState          *bool      `json:"auto_transition_to_maintenance_state,omitempty"`
+	AutoTransitionToOperationalState          *bool      `json:"auto_transition_to_operational_state,omitempty"`
+	AutoTransitionDeliverNotificationsAtStart *bool      `json:"auto_transition_deliver_notifications_at_start,omitempty"`
+	AutoTransitionDeliverNotificationsAtEnd   *bool      `json:"auto_transition_deliver_notifications_at_end,omitempty"`
+	ReminderIntervals                         *string    `json:"reminder_intervals,omitempty"`
+}
+
+func (i Incident) String() string {
+	return Stringify(i)
+}
+
+// CreateIncidentParams are the parameters that can be set using the create incident API endpoint
+type CreateIncidentParams struct {
+	Name                 string                     `json:"name,omitempty"`
+	Status               IncidentStatus             `json:"status,omitempty"`
+	ImpactOverride       IncidentImpact             `json:"impact_override,omitempty"`
+	Body                 string                     `json:"body,omitempty"`
+	DeliverNotifications *bool                      `json:"deliver_notifications,omitempty"`
+	ComponentIDs         []string                   `json:"component_ids,omitempty"`
+	Components           map[string]ComponentStatus `json:"components,omitempty"`
+
+	ScheduledFor                              *Timestamp `json:"scheduled_for,omitempty"`
+	ScheduledUntil                            *Timestamp `json:"scheduled_until,omitempty"`
+	ScheduledRemindPrior                      *bool      `json:"scheduled_remind_prior,omitempty"`
+	ScheduledAutoInProgress                   *bool      `json:"scheduled_auto_in_progress,omitempty"`
+	ScheduledAutoCompleted                    *bool      `json:"scheduled_auto_completed,omitempty"`
+	AutoTransitionToMaintenanceState          *bool      `json:"auto_transition_to_maintenance_state,omitempty"`
+	AutoTransitionToOperationalState          *bool      `json:"auto_transition_to_operational_state,omitempty"`
+	AutoTransitionDeliverNotificationsAtStart *bool      `json:"auto_transition_deliver_notifications_at_start,omitempty"`
+	AutoTransitionDeliverNotificationsAtEnd   *bool      `json:"auto_transition_deliver_notifications_at_end,omitempty"`
+	ReminderIntervals                         string     `json:"reminder_intervals,omitempty"`
+}
+
+// CreateIncidentRequestBody is the create incident request body representation
+type CreateIncidentRequestBody struct {
+	Incident CreateIncidentParams `json:"incident"`
+}
+
+// CreateIncident creates an incident for a given page id
+func (s *IncidentService) CreateIncident(ctx context.Context, pageID string, incident CreateIncidentParams) (*Incident, *Response, error) {
+	path := "v1/pages/" + pageID + "/incidents"
+	payload := CreateIncidentRequestBody{Incident: incident}
+	req, err := s.client.newRequest("POST", path, payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var createdIncident Incident
+	resp, err := s.client.do(ctx, req, &createdIncident)
+
+	return &createdIncident, resp, err
+}
+
+// UpdateIncidentParams are the parameters that can be changed using the update incident API endpoint
+type UpdateIncidentParams struct {
+	Name                 string                     `json:"name,omitempty"`
+	Status               IncidentStatus             `json:"status,omitempty"`
+	ImpactOverride       IncidentImpact             `json:"impact_override,omitempty"`
+	Body                 string                     `json:"body,omitempty"`
+	DeliverNotifications *bool                      `json:"deliver_notifications,omitempty"`
+	ComponentIDs         []string                   `json:"component_ids,omitempty"`
+	Components           map[string]ComponentStatus `json:"components,omitempty"`
+
+	ScheduledFor                              *Timestamp `json:"scheduled_for,omitempty"`
+	ScheduledUntil                            *Timestamp `json:"scheduled_until,omitempty"`
+	ScheduledRemindPrior                      *bool      `json:"scheduled_remind_prior,omitempty"`
+	ScheduledAutoInProgress                   *bool      `json:"scheduled_auto_in_progress,omitempty"`
+	ScheduledAutoCompleted                    *bool      `json:"scheduled_auto_completed,omitempty"`
+	AutoTransitionToMaintenanceState          *bool      `json:"auto_transition_to_maintenance_state,omitempty"`
+	AutoTransitionToOperationalState          *bool      `json:"auto_transition_to_operational_state,omitempty"`
+	AutoTransitionDeliverNotificationsAtStart *bool      `json:"auto_transition_deliver_notifications_at_start,omitempty"`
+	AutoTransitionDeliverNotificationsAtEnd   *bool      `json:"auto_transition_deliver_notifications_at_end,omitempty"`
+	ReminderIntervals                         string     `json:"reminder_intervals,omitempty"`
+}
+
+// UpdateIncidentRequestBody is the update incident request body representation
+type UpdateIncidentRequestBody struct {
+	Incident UpdateIncidentParams `json:"incident"`
+}
+
+// UpdateIncident updates an incident for a given page and incident id
+func (s *IncidentService) UpdateIncident(ctx context.Context, pageID string, incidentID string, incident UpdateIncidentParams) (*Incident, *Response, error) {
+	path := "v1/pages/" + pageID + "/incidents/" + incidentID
+	payload := UpdateIncidentRequestBody{Incident: incident}
+	req, err := s.client.newRequest("PATCH", path, payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var updatedIncident Incident
+	resp, err := s.client.do(ctx, req, &updatedIncident)
+
+	return &updatedIncident, resp, err
+}
+
+// ResolveIncident marks an incident for a given page and incident id as
+// resolved, posting body as the final incident update
+func (s *IncidentService) ResolveIncident(ctx context.Context, pageID string, incidentID string, body string) (*Incident, *Response, error) {
+	return s.UpdateIncident(ctx, pageID, incidentID, UpdateIncidentParams{
+		Status: IncidentStatusResolved,
+		Body:   body,
+	})
+}
+
+// GetIncident returns incident information for a given page and incident id
+func (s *IncidentService) GetIncident(ctx context.Context, pageID string, incidentID string) (*Incident, *Response, error) {
+	path := "v1/pages/" + pageID + "/incidents/" + incidentID
+	req, err := s.client.newRequest("GET", path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var incident Incident
+	resp, err := s.client.do(ctx, req, &incident)
+
+	return &incident, resp, err
+}
+
+// DeleteIncident deletes an incident for a given page and incident id
+func (s *IncidentService) DeleteIncident(ctx context.Context, pageID string, incidentID string) (*Response, error) {
+	path := "v1/pages/" + pageID + "/incidents/" + incidentID
+	req, err := s.client.newRequest("DELETE", path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.do(ctx, req, nil)
+}
+
+// ListIncidents returns a list of incidents for a given page id
+func (s *IncidentService) ListIncidents(ctx context.Context, pageID string, opts *ListOptions) (*[]Incident, *Response, error) {
+	return s.listIncidents(ctx, "v1/pages/"+pageID+"/incidents", opts)
+}
+
+// ListUnresolvedIncidents returns a list of unresolved incidents for a given page id
+func (s *IncidentService) ListUnresolvedIncidents(ctx context.Context, pageID string, opts *ListOptions) (*[]Incident, *Response, error) {
+	return s.listIncidents(ctx, "v1/pages/"+pageID+"/incidents/unresolved", opts)
+}
+
+// ListUpcomingIncidents returns a list of upcoming incidents for a given page id
+func (s *IncidentService) ListUpcomingIncidents(ctx context.Context, pageID string, opts *ListOptions) (*[]Incident, *Response, error) {
+	return s.listIncidents(ctx, "v1/pages/"+pageID+"/incidents/upcoming", opts)
+}
+
+// ListActiveMaintenanceIncidents returns a list of active maintenances for a given page id
+func (s *IncidentService) ListActiveMaintenanceIncidents(ctx context.Context, pageID string, opts *ListOptions) (*[]Incident, *Response, error) {
+	return s.listIncidents(ctx, "v1/pages/"+pageID+"/incidents/active_maintenance", opts)
+}
+
+// ListScheduledIncidents returns a list of scheduled incidents for a given page id
+func (s *IncidentService) ListScheduledIncidents(ctx context.Context, pageID string, opts *ListOptions) (*[]Incident, *Response, error) {
+	return s.listIncidents(ctx, "v1/pages/"+pageID+"/incidents/scheduled", opts)
+}
+
+// All returns an iterator over all incidents for a given page id, fetching
+// further result pages as needed
+func (s *IncidentService) All(ctx context.Context, pageID string) iter.Seq2[Incident, error] {
+	return allPages(ctx, func(ctx context.Context, opts *ListOptions) (*[]Incident, *Response, error) {
+		return s.ListIncidents(ctx, pageID, opts)
+	})
+}
+
+func (s *IncidentService) listIncidents(ctx context.Context, path string, opts *ListOptions) (*[]Incident, *Response, error) {
+	path, err := addOptions(path, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.newRequest("GET", path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var incidents []Incident
+	resp, err := s.client.do(ctx, req, &incidents)
+
+	return &incidents, resp, err
+}
@@ -0,0 +1,312 @@
+package statuspage
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestIncident_marshall(t *testing.T) {
+	testJSONMarshal(t, &Incident{}, "{}")
+
+	u := &Incident{
+		ID:             String("a"),
+		PageID:         String("b"),
+		Name:           String("c"),
+		Status:         String("investigating"),
+		Impact:         String("major"),
+		ImpactOverride: String("critical"),
+		Shortlink:      String("d"),
+		CreatedAt:      &Timestamp{referenceTime},
+		UpdatedAt:      &Timestamp{referenceTime},
+		MonitoringAt:   &Timestamp{referenceTime},
+		ResolvedAt:     &Timestamp{referenceTime},
+		Components: []Component{
+			{ID: String("e")},
+		},
+		IncidentUpdates: []IncidentUpdate{
+			{
+				ID:         String("f"),
+				IncidentID: String("a"),
+				AffectedComponents: []AffectedComponent{
+					{
+						Code:      String("e"),
+						Name:      String("g"),
+						OldStatus: String("operational"),
+						NewStatus: String("major_outage"),
+					},
+				},
+				Body:                 String("h"),
+				CreatedAt:            &Timestamp{referenceTime},
+				CustomTweet:          String("i"),
+				DeliverNotifications: Bool(true),
+				DisplayAt:            &Timestamp{referenceTime},
+				Status:               String("investigating"),
+				TweetID:              String("j"),
+				TwitterUpdatedAt:     &Timestamp{referenceTime},
+				UpdatedAt:            &Timestamp{referenceTime},
+				WantsTwitterUpdate:   Bool(false),
+			},
+		},
+		ScheduledFor:                              &Timestamp{referenceTime},
+		ScheduledUntil:                            &Timestamp{referenceTime},
+		ScheduledRemindPrior:                      Bool(true),
+		ScheduledRemindedAt:                       &Timestamp{referenceTime},
+		ScheduledAutoInProgress:                   Bool(true),
+		ScheduledAutoCompleted:                    Bool(false),
+		AutoTransitionToMaintenanceState:          Bool(true),
+		AutoTransitionToOperationalState:          Bool(false),
+		AutoTransitionDeliverNotificationsAtStart: Bool(true),
+		AutoTransitionDeliverNotificationsAtEnd:   Bool(false),
+		ReminderIntervals:                         String("[3, 6]"),
+	}
+	want := `{
+		"id": "a",
+		"page_id": "b",
+		"name": "c",
+		"status": "investigating",
+		"impact": "major",
+		"impact_override": "critical",
+		"shortlink": "d",
+		"created_at": "2006-01-02T15:04:05Z",
+		"updated_at": "2006-01-02T15:04:05Z",
+		"monitoring_at": "2006-01-02T15:04:05Z",
+		"resolved_at": "2006-01-02T15:04:05Z",
+		"components": [{"id": "e"}],
+		"incident_updates": [{
+			"id": "f",
+			"incident_id": "a",
+			"affected_components": [{
+				"code": "e",
+				"name": "g",
+				"old_status": "operational",
+				"new_status": "major_outage"
+			}],
+			"body": "h",
+			"created_at": "2006-01-02T15:04:05Z",
+			"custom_tweet": "i",
+			"deliver_notifications": true,
+			"display_at": "2006-01-02T15:04:05Z",
+			"status": "investigating",
+			"tweet_id": "j",
+			"twitter_updated_at": "2006-01-02T15:04:05Z",
+			"updated_at": "2006-01-02T15:04:05Z",
+			"wants_twitter_update": false
+		}],
+		"scheduled_for": "2006-01-02T15:04:05Z",
+		"scheduled_until": "2006-01-02T15:04:05Z",
+		"scheduled_remind_prior": true,
+		"scheduled_reminded_at": "2006-01-02T15:04:05Z",
+		"scheduled_auto_in_progress": true,
+		"scheduled_auto_completed": false,
+		"auto_transition_to_maintenance_state": true,
+		"auto_transition_to_operational_state": false,
+		"auto_transition_deliver_notifications_at_start": true,
+		"auto_transition_deliver_notifications_at_end": false,
+		"reminder_intervals": "[3, 6]"
+	}`
+	testJSONMarshal(t, u, want)
+}
+
+func TestIncidentService_CreateIncident(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	input := CreateIncidentParams{
+		Name:         "a",
+		Status:       "investigating",
+		Body:         "b",
+		ComponentIDs: []string{"c"},
+		Components:   map[string]ComponentStatus{"c": ComponentStatusMajorOutage},
+	}
+
+	mux.HandleFunc("/v1/pages/1/incidents", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+
+		v := &CreateIncidentRequestBody{}
+		json.NewDecoder(r.Body).Decode(v)
+		if !reflect.DeepEqual(v.Incident, input) {
+			t.Errorf("Request body = %+v, want %+v", v.Incident, input)
+		}
+
+		fmt.Fprint(w, `{"id":"2", "status": "investigating"}`)
+	})
+
+	incident, _, err := client.Incident.CreateIncident(context.Background(), "1", input)
+	if err != nil {
+		t.Errorf("IncidentService.CreateIncident returned error: %v", err)
+	}
+
+	want := &Incident{ID: String("2"), Status: String("investigating")}
+	if !reflect.DeepEqual(incident, want) {
+		t.Errorf("IncidentService.CreateIncident returned %+v, want %+v", incident, want)
+	}
+}
+
+func TestIncidentService_UpdateIncident(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	input := UpdateIncidentParams{
+		Status:               "monitoring",
+		Body:                 "a",
+		DeliverNotifications: Bool(false),
+	}
+
+	mux.HandleFunc("/v1/pages/1/incidents/2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+
+		v := &UpdateIncidentRequestBody{}
+		json.NewDecoder(r.Body).Decode(v)
+		if !reflect.DeepEqual(v.Incident, input) {
+			t.Errorf("Request body = %+v, want %+v", v.Incident, input)
+		}
+
+		fmt.Fprint(w, `{"id":"2", "status": "monitoring"}`)
+	})
+
+	incident, _, err := client.Incident.UpdateIncident(context.Background(), "1", "2", input)
+	if err != nil {
+		t.Errorf("IncidentService.UpdateIncident returned error: %v", err)
+	}
+
+	want := &Incident{ID: String("2"), Status: String("monitoring")}
+	if !reflect.DeepEqual(incident, want) {
+		t.Errorf("IncidentService.UpdateIncident returned %+v, want %+v", incident, want)
+	}
+}
+
+func TestIncidentService_ResolveIncident(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v1/pages/1/incidents/2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+
+		v := &UpdateIncidentRequestBody{}
+		json.NewDecoder(r.Body).Decode(v)
+		want := UpdateIncidentParams{Status: "resolved", Body: "a"}
+		if !reflect.DeepEqual(v.Incident, want) {
+			t.Errorf("Request body = %+v, want %+v", v.Incident, want)
+		}
+
+		fmt.Fprint(w, `{"id":"2", "status": "resolved"}`)
+	})
+
+	incident, _, err := client.Incident.ResolveIncident(context.Background(), "1", "2", "a")
+	if err != nil {
+		t.Errorf("IncidentService.ResolveIncident returned error: %v", err)
+	}
+
+	want := &Incident{ID: String("2"), Status: String("resolved")}
+	if !reflect.DeepEqual(incident, want) {
+		t.Errorf("IncidentService.ResolveIncident returned %+v, want %+v", incident, want)
+	}
+}
+
+func TestIncidentService_GetIncident(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v1/pages/1/incidents/2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id":"2"}`)
+	})
+
+	incident, _, err := client.Incident.GetIncident(context.Background(), "1", "2")
+	if err != nil {
+		t.Errorf("IncidentService.GetIncident returned error: %v", err)
+	}
+
+	want := &Incident{ID: String("2")}
+	if !reflect.DeepEqual(incident, want) {
+		t.Errorf("IncidentService.GetIncident returned %+v, want %+v", incident, want)
+	}
+}
+
+func TestIncidentService_DeleteIncident(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v1/pages/1/incidents/2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		fmt.Fprint(w, `{}`)
+	})
+
+	_, err := client.Incident.DeleteIncident(context.Background(), "1", "2")
+	if err != nil {
+		t.Errorf("IncidentService.DeleteIncident returned error: %v", err)
+	}
+}
+
+func TestIncidentService_ListIncidents(t *testing.T) {
+	tests := []struct {
+		name string
+		path string
+		list func(client *Client) (*[]Incident, *Response, error)
+	}{
+		{
+			name: "ListIncidents",
+			path: "/v1/pages/1/incidents",
+			list: func(c *Client) (*[]Incident, *Response, error) {
+				return c.Incident.ListIncidents(context.Background(), "1", nil)
+			},
+		},
+		{
+			name: "ListUnresolvedIncidents",
+			path: "/v1/pages/1/incidents/unresolved",
+			list: func(c *Client) (*[]Incident, *Response, error) {
+				return c.Incident.ListUnresolvedIncidents(context.Background(), "1", nil)
+			},
+		},
+		{
+			name: "ListUpcomingIncidents",
+			path: "/v1/pages/1/incidents/upcoming",
+			list: func(c *Client) (*[]Incident, *Response, error) {
+				return c.Incident.ListUpcomingIncidents(context.Background(), "1", nil)
+			},
+		},
+		{
+			name: "ListActiveMaintenanceIncidents",
+			path: "/v1/pages/1/incidents/active_maintenance",
+			list: func(c *Client) (*[]Incident, *Response, error) {
+				return c.Incident.ListActiveMaintenanceIncidents(context.Background(), "1", nil)
+			},
+		},
+		{
+			name: "ListScheduledIncidents",
+			path: "/v1/pages/1/incidents/scheduled",
+			list: func(c *Client) (*[]Incident, *Response, error) {
+				return c.Incident.ListScheduledIncidents(context.Background(), "1", nil)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, mux, _, teardown := setup()
+			defer teardown()
+
+			mux.HandleFunc(tt.path, func(w http.ResponseWriter, r *http.Request) {
+				testMethod(t, r, "GET")
+				fmt.Fprint(w, `[{"id":"1"}, {"id":"2"}]`)
+			})
+
+			incidents, _, err := tt.list(client)
+			if err != nil {
+				t.Errorf("IncidentService.%s returned error: %v", tt.name, err)
+			}
+
+			want := &[]Incident{
+				{ID: String("1")},
+				{ID: String("2")},
+			}
+			if !reflect.DeepEqual(incidents, want) {
+				t.Errorf("IncidentService.%s returned %+v, want %+v", tt.name, incidents, want)
+			}
+		})
+	}
+}
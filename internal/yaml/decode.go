@@ -0,0 +1,620 @@
+package yaml
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// ToJSON converts a YAML document to JSON. It supports block mappings and
+// sequences, flow collections of scalars, plain and quoted scalars, literal
+// and folded block scalars, and comments. Anchors, aliases, tags and
+// multiple documents are rejected.
+func ToJSON(data []byte) ([]byte, error) {
+	node, err := parse(data)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(node)
+}
+
+// Unmarshal decodes a YAML document into v through its JSON representation,
+// so json struct tags and Unmarshaler implementations apply. Plain scalars
+// that read as numbers decode into string values as written, e.g. name: 2024
+// sets a string field to "2024". Unlike json.Unmarshal, keys without a
+// matching struct field are an error, as they are usually typos.
+func Unmarshal(data []byte, v interface{}) error {
+	node, err := parse(data)
+	if err != nil {
+		return err
+	}
+	data, err = json.Marshal(toType(node, reflect.TypeOf(v)))
+	if err != nil {
+		return err
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+	return dec.Decode(v)
+}
+
+// parse parses a YAML document into nested maps, slices and scalars.
+func parse(data []byte) (interface{}, error) {
+	lines, err := splitLines(string(data))
+	if err != nil {
+		return nil, err
+	}
+
+	p := &parser{lines: lines}
+	first := p.next()
+	if first == nil {
+		return nil, nil
+	}
+	node, err := p.parseNode(first.indent)
+	if err != nil {
+		return nil, err
+	}
+	if l := p.next(); l != nil {
+		return nil, p.errorf("unexpected content %q", l.text)
+	}
+	return node, nil
+}
+
+var unmarshalerType = reflect.TypeFor[json.Unmarshaler]()
+
+// toType returns node with the numbers that are decoded into strings of type
+// t replaced by their text.
+func toType(node interface{}, t reflect.Type) interface{} {
+	for t != nil && t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t == nil || reflect.PointerTo(t).Implements(unmarshalerType) {
+		return node
+	}
+
+	switch node := node.(type) {
+	case json.Number:
+		if t.Kind() == reflect.String {
+			return string(node)
+		}
+	case []interface{}:
+		if t.Kind() == reflect.Slice || t.Kind() == reflect.Array {
+			for i, item := range node {
+				node[i] = toType(item, t.Elem())
+			}
+		}
+	case map[string]interface{}:
+		switch t.Kind() {
+		case reflect.Map:
+			for key, value := range node {
+				node[key] = toType(value, t.Elem())
+			}
+		case reflect.Struct:
+			for key, value := range node {
+				if field, ok := fieldType(t, key); ok {
+					node[key] = toType(value, field)
+				}
+			}
+		}
+	}
+	return node
+}
+
+// fieldType returns the type of the field of struct type t that the JSON key
+// decodes into, matching names like encoding/json does.
+func fieldType(t reflect.Type, key string) (reflect.Type, bool) {
+	var fold reflect.Type
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		name, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+		if name == "-" || !f.IsExported() && !f.Anonymous {
+			continue
+		}
+		if f.Anonymous && name == "" {
+			ft := f.Type
+			if ft.Kind() == reflect.Pointer {
+				ft = ft.Elem()
+			}
+			if ft.Kind() == reflect.Struct {
+				if typ, ok := fieldType(ft, key); ok {
+					return typ, true
+				}
+				continue
+			}
+		}
+		if name == "" {
+			name = f.Name
+		}
+		if name == key {
+			return f.Type, true
+		}
+		if fold == nil && strings.EqualFold(name, key) {
+			fold = f.Type
+		}
+	}
+	return fold, fold != nil
+}
+
+// line is a line of a document without its indentation and trailing
+// comment.
+type line struct {
+	num    int
+	indent int
+	text   string
+
+	// raw is the line without its indentation, before comments were
+	// removed, for block scalars.
+	raw string
+}
+
+func splitLines(doc string) ([]line, error) {
+	var lines []line
+	for i, text := range strings.Split(strings.ReplaceAll(doc, "\r\n", "\n"), "\n") {
+		trimmed := strings.TrimLeft(text, " ")
+		indent := len(text) - len(trimmed)
+		if strings.HasPrefix(trimmed, "\t") {
+			return nil, fmt.Errorf("yaml: line %d: tabs are not allowed in indentation", i+1)
+		}
+		content := strings.TrimRight(stripComment(trimmed), " \t")
+
+		if indent == 0 && (content == "---" || content == "...") {
+			if len(lines) > 0 {
+				return nil, fmt.Errorf("yaml: line %d: multiple documents are not supported", i+1)
+			}
+			continue
+		}
+		lines = append(lines, line{num: i + 1, indent: indent, text: content, raw: trimmed})
+	}
+	return lines, nil
+}
+
+// stripComment removes a comment from s, which starts with # at the
+// beginning or after whitespace, outside of quotes.
+func stripComment(s string) string {
+	var quote byte
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case quote == '"' && c == '\\':
+			i++
+		case quote == '\'' && c == '\'' && i+1 < len(s) && s[i+1] == '\'':
+			i++
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case c == '"' || c == '\'':
+			if i == 0 || strings.IndexByte(" [{,", s[i-1]) >= 0 {
+				quote = c
+			}
+		case c == '#':
+			if i == 0 || s[i-1] == ' ' || s[i-1] == '\t' {
+				return s[:i]
+			}
+		}
+	}
+	return s
+}
+
+type parser struct {
+	lines []line
+	pos   int
+}
+
+func (p *parser) errorf(format string, args ...interface{}) error {
+	num := 0
+	if p.pos < len(p.lines) {
+		num = p.lines[p.pos].num
+	} else if len(p.lines) > 0 {
+		num = p.lines[len(p.lines)-1].num
+	}
+	return fmt.Errorf("yaml: line %d: %s", num, fmt.Sprintf(format, args...))
+}
+
+// next returns the next content line, skipping blank ones, or nil.
+func (p *parser) next() *line {
+	for p.pos < len(p.lines) && p.lines[p.pos].text == "" {
+		p.pos++
+	}
+	if p.pos == len(p.lines) {
+		return nil
+	}
+	return &p.lines[p.pos]
+}
+
+// parseNode parses the node starting at the next line, which must be
+// indented by indent.
+func (p *parser) parseNode(indent int) (interface{}, error) {
+	l := p.next()
+	if l == nil {
+		return nil, nil
+	}
+	if l.indent != indent {
+		return nil, p.errorf("bad indentation")
+	}
+
+	switch {
+	case isSequenceItem(l.text):
+		return p.parseSequence(indent)
+	case mappingKey(l.text) >= 0:
+		return p.parseMapping(indent)
+	}
+
+	v, err := parseInline(l.text)
+	if err != nil {
+		return nil, p.errorf("%v", err)
+	}
+	p.pos++
+	return v, nil
+}
+
+func isSequenceItem(text string) bool {
+	return text == "-" || strings.HasPrefix(text, "- ")
+}
+
+func (p *parser) parseSequence(indent int) (interface{}, error) {
+	items := []interface{}{}
+	for {
+		l := p.next()
+		if l == nil || l.indent < indent {
+			return items, nil
+		}
+		if l.indent > indent {
+			return nil, p.errorf("bad indentation")
+		}
+		if !isSequenceItem(l.text) {
+			return items, nil
+		}
+
+		rest := strings.TrimLeft(strings.TrimPrefix(l.text, "-"), " ")
+		if rest == "" {
+			p.pos++
+			item, err := p.parseNested(indent)
+			if err != nil {
+				return nil, err
+			}
+			items = append(items, item)
+			continue
+		}
+
+		// The item's content continues on this line. Parse it as if it
+		// started on a line of its own, at the column it starts at.
+		offset := len(l.text) - len(rest)
+		l.indent += offset
+		l.text = rest
+		l.raw = l.raw[offset:]
+		item, err := p.parseNode(l.indent)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+}
+
+// parseNested parses the value of a sequence item or mapping key that starts
+// on the line after the one at indent, or returns nil if there is none.
+func (p *parser) parseNested(indent int) (interface{}, error) {
+	l := p.next()
+	if l == nil || l.indent <= indent {
+		return nil, nil
+	}
+	return p.parseNode(l.indent)
+}
+
+func (p *parser) parseMapping(indent int) (interface{}, error) {
+	m := map[string]interface{}{}
+	for {
+		l := p.next()
+		if l == nil || l.indent < indent {
+			return m, nil
+		}
+		if l.indent > indent {
+			return nil, p.errorf("bad indentation")
+		}
+		if isSequenceItem(l.text) {
+			return m, nil
+		}
+
+		i := mappingKey(l.text)
+		if i < 0 {
+			return nil, p.errorf("expected a mapping key, got %q", l.text)
+		}
+		key, err := parseKey(l.text[:i])
+		if err != nil {
+			return nil, p.errorf("%v", err)
+		}
+		if _, ok := m[key]; ok {
+			return nil, p.errorf("duplicate key %q", key)
+		}
+		rest := strings.TrimSpace(l.text[i+1:])
+
+		var value interface{}
+		switch {
+		case rest == "":
+			p.pos++
+			// Sequences may start at the indentation of their key.
+			if next := p.next(); next != nil && next.indent == indent && isSequenceItem(next.text) {
+				value, err = p.parseSequence(indent)
+			} else {
+				value, err = p.parseNested(indent)
+			}
+			if err != nil {
+				return nil, err
+			}
+		case rest[0] == '|' || rest[0] == '>':
+			if value, err = p.parseBlockScalar(rest, indent); err != nil {
+				return nil, p.errorf("%v", err)
+			}
+		default:
+			if value, err = parseInline(rest); err != nil {
+				return nil, p.errorf("%v", err)
+			}
+			p.pos++
+		}
+		m[key] = value
+	}
+}
+
+// mappingKey returns the index of the colon ending the mapping key of text,
+// or -1 if text is not a mapping entry.
+func mappingKey(text string) int {
+	if text == "" {
+		return -1
+	}
+	start := 0
+	if text[0] == '"' || text[0] == '\'' {
+		end := closingQuote(text)
+		if end < 0 {
+			return -1
+		}
+		start = end + 1
+	} else if strings.ContainsRune("[{", rune(text[0])) {
+		return -1
+	}
+	for i := start; i < len(text); i++ {
+		if text[i] == ':' && (i+1 == len(text) || text[i+1] == ' ') {
+			return i
+		}
+	}
+	return -1
+}
+
+// closingQuote returns the index of the quote closing the string that text
+// starts with, or -1.
+func closingQuote(text string) int {
+	quote := text[0]
+	for i := 1; i < len(text); i++ {
+		switch {
+		case quote == '"' && text[i] == '\\':
+			i++
+		case text[i] == quote:
+			if quote == '\'' && i+1 < len(text) && text[i+1] == '\'' {
+				i++
+				continue
+			}
+			return i
+		}
+	}
+	return -1
+}
+
+func parseKey(text string) (string, error) {
+	text = strings.TrimSpace(text)
+	v, err := parseInline(text)
+	if err != nil {
+		return "", err
+	}
+	switch v := v.(type) {
+	case string:
+		return v, nil
+	case json.Number:
+		return v.String(), nil
+	case bool:
+		return strconv.FormatBool(v), nil
+	}
+	return "", fmt.Errorf("unsupported mapping key %q", text)
+}
+
+// parseBlockScalar parses a literal (|) or folded (>) block scalar whose
+// header is header, on the current line, and whose content is indented deeper
+// than indent.
+func (p *parser) parseBlockScalar(header string, indent int) (interface{}, error) {
+	folded := header[0] == '>'
+	chomp := strings.TrimSpace(header[1:])
+	if chomp != "" && chomp != "-" && chomp != "+" {
+		return nil, fmt.Errorf("unsupported block scalar header %q", header)
+	}
+	p.pos++
+
+	var content []string
+	blockIndent := -1
+	for ; p.pos < len(p.lines); p.pos++ {
+		l := p.lines[p.pos]
+		if strings.TrimSpace(l.raw) == "" {
+			content = append(content, "")
+			continue
+		}
+		if l.indent <= indent {
+			break
+		}
+		if blockIndent < 0 {
+			blockIndent = l.indent
+		}
+		if l.indent < blockIndent {
+			break
+		}
+		content = append(content, strings.Repeat(" ", l.indent-blockIndent)+l.raw)
+	}
+
+	// Trailing empty lines belong to the chomping, not the content.
+	trailing := 0
+	for len(content) > 0 && content[len(content)-1] == "" {
+		content = content[:len(content)-1]
+		trailing++
+	}
+
+	var s string
+	if folded {
+		var b strings.Builder
+		for i, text := range content {
+			switch {
+			case i == 0:
+			case text == "" || content[i-1] == "" || strings.HasPrefix(text, " "):
+				b.WriteString("\n")
+			default:
+				b.WriteString(" ")
+			}
+			b.WriteString(text)
+		}
+		s = b.String()
+	} else {
+		s = strings.Join(content, "\n")
+	}
+
+	switch {
+	case len(content) == 0:
+	case chomp == "-":
+	case chomp == "+":
+		s += strings.Repeat("\n", trailing+1)
+	default:
+		s += "\n"
+	}
+	return s, nil
+}
+
+// parseInline parses a scalar or a flow collection written on a single line.
+func parseInline(text string) (interface{}, error) {
+	v, rest, err := parseFlow(text, false)
+	if err != nil {
+		return nil, err
+	}
+	if strings.TrimSpace(rest) != "" {
+		return nil, fmt.Errorf("unexpected %q after value", rest)
+	}
+	return v, nil
+}
+
+// parseFlow parses the value text starts with and returns the remaining
+// text. Inside flow collections, plain scalars end at a comma or closing
+// bracket.
+func parseFlow(text string, inFlow bool) (interface{}, string, error) {
+	text = strings.TrimLeft(text, " ")
+	if text == "" {
+		return nil, "", nil
+	}
+
+	switch text[0] {
+	case '&', '*', '!':
+		return nil, "", fmt.Errorf("anchors, aliases and tags are not supported")
+	case '"':
+		end := closingQuote(text)
+		if end < 0 {
+			return nil, "", fmt.Errorf("unterminated string %s", text)
+		}
+		s, err := strconv.Unquote(text[:end+1])
+		if err != nil {
+			return nil, "", fmt.Errorf("invalid string %s", text[:end+1])
+		}
+		return s, text[end+1:], nil
+	case '\'':
+		end := closingQuote(text)
+		if end < 0 {
+			return nil, "", fmt.Errorf("unterminated string %s", text)
+		}
+		return strings.ReplaceAll(text[1:end], "''", "'"), text[end+1:], nil
+	case '[':
+		return parseFlowSequence(text[1:])
+	case '{':
+		return parseFlowMapping(text[1:])
+	}
+
+	end := len(text)
+	if inFlow {
+		if i := strings.IndexAny(text, ",]}"); i >= 0 {
+			end = i
+		}
+	}
+	return parsePlain(strings.TrimSpace(text[:end])), text[end:], nil
+}
+
+func parseFlowSequence(text string) (interface{}, string, error) {
+	items := []interface{}{}
+	for {
+		text = strings.TrimLeft(text, " ")
+		if strings.HasPrefix(text, "]") {
+			return items, text[1:], nil
+		}
+		item, rest, err := parseFlow(text, true)
+		if err != nil {
+			return nil, "", err
+		}
+		items = append(items, item)
+		rest = strings.TrimLeft(rest, " ")
+		switch {
+		case strings.HasPrefix(rest, ","):
+			text = rest[1:]
+		case strings.HasPrefix(rest, "]"):
+			return items, rest[1:], nil
+		default:
+			return nil, "", fmt.Errorf("unterminated flow sequence")
+		}
+	}
+}
+
+func parseFlowMapping(text string) (interface{}, string, error) {
+	m := map[string]interface{}{}
+	for {
+		text = strings.TrimLeft(text, " ")
+		if strings.HasPrefix(text, "}") {
+			return m, text[1:], nil
+		}
+		i := strings.Index(text, ":")
+		if i < 0 {
+			return nil, "", fmt.Errorf("unterminated flow mapping")
+		}
+		key, err := parseKey(text[:i])
+		if err != nil {
+			return nil, "", err
+		}
+		value, rest, err := parseFlow(text[i+1:], true)
+		if err != nil {
+			return nil, "", err
+		}
+		m[key] = value
+		rest = strings.TrimLeft(rest, " ")
+		switch {
+		case strings.HasPrefix(rest, ","):
+			text = rest[1:]
+		case strings.HasPrefix(rest, "}"):
+			return m, rest[1:], nil
+		default:
+			return nil, "", fmt.Errorf("unterminated flow mapping")
+		}
+	}
+}
+
+// parsePlain resolves a plain scalar to null, a boolean, a number or a
+// string, following the YAML 1.2 core schema.
+func parsePlain(s string) interface{} {
+	switch s {
+	case "", "~", "null", "Null", "NULL":
+		return nil
+	case "true", "True", "TRUE":
+		return true
+	case "false", "False", "FALSE":
+		return false
+	}
+	if isNumber(s) {
+		return json.Number(s)
+	}
+	return s
+}
+
+// isNumber reports whether s is a decimal number that is valid JSON.
+func isNumber(s string) bool {
+	var v json.Number
+	return json.Unmarshal([]byte(s), &v) == nil
+}
@@ -0,0 +1,169 @@
+package yaml
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestToJSON(t *testing.T) {
+	doc := `---
+# Desired state of the page.
+page:
+  name: Example   # trailing comment
+  time_zone: "Etc/UTC"
+  hidden_from_search: false
+components:
+- name: API
+  description: 'It''s the API'
+  tags: [core, "edge, cdn"]
+- name: "Web: frontend"
+  position: 2
+  notes: |
+    first line
+    second # not a comment
+
+  summary: >-
+    folded
+    text
+-   name: Search
+    meta: {owner: search, oncall: ~}
+empty:
+nested:
+  - - 1
+    - 2.5
+  -
+    key: value
+`
+
+	got, err := ToJSON([]byte(doc))
+	if err != nil {
+		t.Fatalf("ToJSON returned error: %v", err)
+	}
+
+	var v interface{}
+	if err := json.Unmarshal(got, &v); err != nil {
+		t.Fatalf("ToJSON returned invalid JSON %s: %v", got, err)
+	}
+	want := map[string]interface{}{
+		"page": map[string]interface{}{
+			"name":               "Example",
+			"time_zone":          "Etc/UTC",
+			"hidden_from_search": false,
+		},
+		"components": []interface{}{
+			map[string]interface{}{
+				"name":        "API",
+				"description": "It's the API",
+				"tags":        []interface{}{"core", "edge, cdn"},
+			},
+			map[string]interface{}{
+				"name":     "Web: frontend",
+				"position": 2.0,
+				"notes":    "first line\nsecond # not a comment\n",
+				"summary":  "folded text",
+			},
+			map[string]interface{}{
+				"name": "Search",
+				"meta": map[string]interface{}{"owner": "search", "oncall": nil},
+			},
+		},
+		"empty": nil,
+		"nested": []interface{}{
+			[]interface{}{1.0, 2.5},
+			map[string]interface{}{"key": "value"},
+		},
+	}
+	if !reflect.DeepEqual(v, want) {
+		t.Errorf("ToJSON returned %s, want %+v", got, want)
+	}
+}
+
+func TestToJSON_roundTrip(t *testing.T) {
+	v := map[string]interface{}{
+		"name":  "Example: status",
+		"empty": "",
+		"count": "42",
+		"list":  []interface{}{"a", map[string]interface{}{"b": true, "c": nil}, []interface{}{}},
+		"map":   map[string]interface{}{},
+		"text":  "two\nlines # and a hash",
+	}
+
+	data, err := Marshal(v)
+	if err != nil {
+		t.Fatalf("Marshal returned error: %v", err)
+	}
+	got, err := ToJSON(data)
+	if err != nil {
+		t.Fatalf("ToJSON returned error for\n%s: %v", data, err)
+	}
+
+	var decoded interface{}
+	json.Unmarshal(got, &decoded)
+	if !reflect.DeepEqual(decoded, v) {
+		t.Errorf("ToJSON(Marshal(v)) returned %s, want %+v", got, v)
+	}
+}
+
+func TestToJSON_errors(t *testing.T) {
+	tests := []struct {
+		doc  string
+		want string
+	}{
+		{"a: 1\na: 2", "line 2: duplicate key"},
+		{"a:\n\tb: 1", "line 2: tabs"},
+		{"a: 1\n   b: 2", "line 2: bad indentation"},
+		{"a: &anchor 1", "line 1: anchors"},
+		{"a: \"open", "line 1: unterminated"},
+		{"a: 1\n---\nb: 2", "line 2: multiple documents"},
+		{"- a\nb: 1", "line 2: unexpected content"},
+	}
+
+	for _, tt := range tests {
+		_, err := ToJSON([]byte(tt.doc))
+		if err == nil || !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("ToJSON(%q) returned error %v, want %q", tt.doc, err, tt.want)
+		}
+	}
+}
+
+func TestUnmarshal(t *testing.T) {
+	type item struct {
+		Name  string   `json:"name"`
+		Count int      `json:"count"`
+		Tags  []string `json:"tags"`
+	}
+	type doc struct {
+		Items  []item            `json:"items"`
+		Labels map[string]string `json:"labels"`
+		Raw    interface{}       `json:"raw"`
+	}
+	data := `
+items:
+- name: 2024
+  count: 3
+  tags: [1.0, edge, "007"]
+labels:
+  version: 1.10
+raw: 5
+`
+
+	var got doc
+	if err := Unmarshal([]byte(data), &got); err != nil {
+		t.Fatalf("Unmarshal returned error: %v", err)
+	}
+	want := doc{
+		Items:  []item{{Name: "2024", Count: 3, Tags: []string{"1.0", "edge", "007"}}},
+		Labels: map[string]string{"version": "1.10"},
+		Raw:    float64(5),
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Unmarshal returned %+v, want %+v", got, want)
+	}
+
+	err := Unmarshal([]byte("items:\n- nmae: x\n"), &got)
+	if err == nil || !strings.Contains(err.Error(), `unknown field "nmae"`) {
+		t.Errorf("Unmarshal with unknown field returned error %v", err)
+	}
+}
@@ -0,0 +1,190 @@
+// Package yaml converts between Go values and the block style subset of
+// YAML used by the command-line tool and the pagesync package. Values are
+// converted through their JSON representation, so json struct tags and
+// Marshaler implementations apply.
+package yaml
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// field is a key and value of a mapping, which keeps the key order of the
+// JSON object it was decoded from.
+type field struct {
+	key   string
+	value interface{}
+}
+
+// mapping is a decoded JSON object.
+type mapping []field
+
+// Marshal returns the YAML encoding of v.
+func Marshal(v interface{}) ([]byte, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	node, err := decodeValue(dec)
+	if err != nil {
+		return nil, err
+	}
+
+	var b bytes.Buffer
+	encodeValue(&b, node, 0)
+	return b.Bytes(), nil
+}
+
+// decodeValue decodes the next JSON value of dec into a mapping, a
+// []interface{} or a scalar.
+func decodeValue(dec *json.Decoder) (interface{}, error) {
+	tok, err := dec.Token()
+	if err != nil {
+		return nil, err
+	}
+
+	switch tok {
+	case json.Delim('{'):
+		m := mapping{}
+		for dec.More() {
+			key, err := dec.Token()
+			if err != nil {
+				return nil, err
+			}
+			value, err := decodeValue(dec)
+			if err != nil {
+				return nil, err
+			}
+			m = append(m, field{key.(string), value})
+		}
+		_, err := dec.Token()
+		return m, err
+	case json.Delim('['):
+		s := []interface{}{}
+		for dec.More() {
+			value, err := decodeValue(dec)
+			if err != nil {
+				return nil, err
+			}
+			s = append(s, value)
+		}
+		_, err := dec.Token()
+		return s, err
+	case json.Delim('}'), json.Delim(']'):
+		return nil, errors.New("yaml: unexpected end of JSON value")
+	}
+	return tok, nil
+}
+
+// encodeValue writes node at the given indentation, ending with a newline.
+// The caller has already written the indentation of the first line.
+func encodeValue(w io.Writer, node interface{}, indent int) {
+	switch node := node.(type) {
+	case mapping:
+		if len(node) == 0 {
+			io.WriteString(w, "{}\n")
+			return
+		}
+		for i, f := range node {
+			if i > 0 {
+				writeIndent(w, indent)
+			}
+			io.WriteString(w, formatScalar(f.key)+":")
+			encodeNested(w, f.value, indent)
+		}
+	case []interface{}:
+		if len(node) == 0 {
+			io.WriteString(w, "[]\n")
+			return
+		}
+		for i, item := range node {
+			if i > 0 {
+				writeIndent(w, indent)
+			}
+			io.WriteString(w, "- ")
+			encodeValue(w, item, indent+2)
+		}
+	default:
+		io.WriteString(w, formatScalar(node)+"\n")
+	}
+}
+
+// encodeNested writes the value of a mapping key at the given indentation of
+// the key.
+func encodeNested(w io.Writer, node interface{}, indent int) {
+	switch n := node.(type) {
+	case mapping:
+		if len(n) > 0 {
+			io.WriteString(w, "\n")
+			writeIndent(w, indent+2)
+			encodeValue(w, n, indent+2)
+			return
+		}
+	case []interface{}:
+		if len(n) > 0 {
+			// Sequences are not indented below their key, like kubectl
+			// prints them.
+			io.WriteString(w, "\n")
+			writeIndent(w, indent)
+			encodeValue(w, n, indent)
+			return
+		}
+	}
+	io.WriteString(w, " ")
+	encodeValue(w, node, indent+2)
+}
+
+func writeIndent(w io.Writer, indent int) {
+	io.WriteString(w, strings.Repeat(" ", indent))
+}
+
+// formatScalar returns the YAML representation of a JSON scalar. Strings are
+// quoted if they would otherwise read as another type or break the syntax.
+func formatScalar(v interface{}) string {
+	switch v := v.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return strconv.FormatBool(v)
+	case json.Number:
+		return v.String()
+	case string:
+		if needsQuotes(v) {
+			return strconv.Quote(v)
+		}
+		return v
+	}
+	panic("yaml: unexpected scalar type")
+}
+
+func needsQuotes(s string) bool {
+	if s == "" || strings.TrimSpace(s) != s {
+		return true
+	}
+	switch strings.ToLower(s) {
+	case "~", "null", "true", "false", "yes", "no", "on", "off", "y", "n":
+		return true
+	}
+	if _, err := strconv.ParseFloat(s, 64); err == nil {
+		return true
+	}
+	if strings.ContainsAny(s[:1], "-?:,[]{}#&*!|>'\"%@`") {
+		return true
+	}
+	if strings.Contains(s, ": ") || strings.Contains(s, " #") || strings.HasSuffix(s, ":") {
+		return true
+	}
+	for _, r := range s {
+		if r < ' ' || r == 0x7f {
+			return true
+		}
+	}
+	return false
+}
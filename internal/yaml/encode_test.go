@@ -0,0 +1,87 @@
+package yaml
+
+import (
+	"testing"
+)
+
+func TestMarshal(t *testing.T) {
+	type component struct {
+		ID     string   `json:"id"`
+		Name   string   `json:"name"`
+		Status *string  `json:"status,omitempty"`
+		Tags   []string `json:"tags"`
+	}
+	type page struct {
+		Name       string            `json:"name"`
+		Components []component       `json:"components"`
+		Meta       map[string]string `json:"meta"`
+		Matrix     [][]int           `json:"matrix"`
+	}
+
+	status := "operational"
+	v := page{
+		Name: "Example: status",
+		Components: []component{
+			{ID: "a", Name: "API", Status: &status, Tags: []string{"core", "true"}},
+			{ID: "b", Name: "Web", Tags: []string{}},
+		},
+		Meta:   map[string]string{},
+		Matrix: [][]int{{1, 2}, {3}},
+	}
+
+	got, err := Marshal(v)
+	if err != nil {
+		t.Fatalf("Marshal returned error: %v", err)
+	}
+
+	want := `name: "Example: status"
+components:
+- id: a
+  name: API
+  status: operational
+  tags:
+  - core
+  - "true"
+- id: b
+  name: Web
+  tags: []
+meta: {}
+matrix:
+- - 1
+  - 2
+- - 3
+`
+	if string(got) != want {
+		t.Errorf("Marshal returned\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestMarshal_scalars(t *testing.T) {
+	tests := []struct {
+		v    interface{}
+		want string
+	}{
+		{nil, "null\n"},
+		{true, "true\n"},
+		{1.5, "1.5\n"},
+		{"plain text", "plain text\n"},
+		{"", "\"\"\n"},
+		{"null", "\"null\"\n"},
+		{"42", "\"42\"\n"},
+		{"- item", "\"- item\"\n"},
+		{" padded", "\" padded\"\n"},
+		{"a # comment", "\"a # comment\"\n"},
+		{"two\nlines", "\"two\\nlines\"\n"},
+		{"2006-01-02T15:04:05Z", "2006-01-02T15:04:05Z\n"},
+	}
+
+	for _, tt := range tests {
+		got, err := Marshal(tt.v)
+		if err != nil {
+			t.Fatalf("Marshal(%#v) returned error: %v", tt.v, err)
+		}
+		if string(got) != tt.want {
+			t.Errorf("Marshal(%#v) returned %q, want %q", tt.v, got, tt.want)
+		}
+	}
+}
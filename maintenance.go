@@ -0,0 +1,132 @@
+package statuspage
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// MaintenanceWindow describes a scheduled maintenance. It is turned into the
+// incident create request body by CreateIncidentParams.
+type MaintenanceWindow struct {
+	Name string
+	Body string
+
+	// Start and End bound the maintenance. End must be after Start.
+	Start time.Time
+	End   time.Time
+
+	// ComponentIDs lists the components affected by the maintenance.
+	ComponentIDs []string
+
+	// AutoTransition lets Statuspage move the maintenance to in progress at
+	// Start and to completed at End, setting the affected components to
+	// under maintenance and back to operational along the way.
+	AutoTransition bool
+
+	// NotifyAtStart and NotifyAtEnd control whether subscribers are notified
+	// of the automatic transitions.
+	NotifyAtStart bool
+	NotifyAtEnd   bool
+
+	// ReminderIntervals are the hours before Start at which subscribers are
+	// reminded of the maintenance. No reminders are sent if it is empty.
+	ReminderIntervals []int
+
+	// DeliverNotifications controls whether subscribers are notified of the
+	// maintenance being scheduled. Defaults to the API default if nil.
+	DeliverNotifications *bool
+}
+
+// CreateIncidentParams returns the create incident parameters scheduling the
+// maintenance window w, or an error if w is incomplete.
+func (w MaintenanceWindow) CreateIncidentParams() (CreateIncidentParams, error) {
+	if w.Name == "" {
+		return CreateIncidentParams{}, errors.New("maintenance window name is required")
+	}
+	if w.Start.IsZero() || w.End.IsZero() {
+		return CreateIncidentParams{}, errors.New("maintenance window start and end are required")
+	}
+	if !w.End.After(w.Start) {
+		return CreateIncidentParams{}, errors.New("maintenance window must end after it starts")
+	}
+
+	params := CreateIncidentParams{
+		Name:                 w.Name,
+		Status:               IncidentStatusScheduled,
+		ImpactOverride:       IncidentImpactMaintenance,
+		Body:                 w.Body,
+		DeliverNotifications: w.DeliverNotifications,
+		ComponentIDs:         w.ComponentIDs,
+		ScheduledFor:         &Timestamp{w.Start},
+		ScheduledUntil:       &Timestamp{w.End},
+	}
+
+	enabled := true
+	if w.AutoTransition {
+		notifyAtStart, notifyAtEnd := w.NotifyAtStart, w.NotifyAtEnd
+		params.ScheduledAutoInProgress = &enabled
+		params.ScheduledAutoCompleted = &enabled
+		params.AutoTransitionToMaintenanceState = &enabled
+		params.AutoTransitionToOperationalState = &enabled
+		params.AutoTransitionDeliverNotificationsAtStart = &notifyAtStart
+		params.AutoTransitionDeliverNotificationsAtEnd = &notifyAtEnd
+	}
+
+	if len(w.ReminderIntervals) > 0 {
+		params.ScheduledRemindPrior = &enabled
+		params.ReminderIntervals = formatReminderIntervals(w.ReminderIntervals)
+	}
+
+	return params, nil
+}
+
+// formatReminderIntervals renders hours the way the API expects them, e.g.
+// "[3, 6, 12, 24]".
+func formatReminderIntervals(hours []int) string {
+	parts := make([]string, len(hours))
+	for i, h := range hours {
+		parts[i] = strconv.Itoa(h)
+	}
+	return "[" + strings.Join(parts, ", ") + "]"
+}
+
+// ScheduleMaintenance creates a scheduled maintenance for a given page id
+func (s *IncidentService) ScheduleMaintenance(ctx context.Context, pageID string, window MaintenanceWindow) (*Incident, *Response, error) {
+	params, err := window.CreateIncidentParams()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return s.CreateIncident(ctx, pageID, params)
+}
+
+// CompleteMaintenance completes a scheduled maintenance for a given page and
+// incident id ahead of its scheduled end, posting body as the final update.
+// Affected components that are still under maintenance are set back to
+// operational.
+func (s *IncidentService) CompleteMaintenance(ctx context.Context, pageID string, incidentID string, body string) (*Incident, *Response, error) {
+	incident, resp, err := s.GetIncident(ctx, pageID, incidentID)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	params := UpdateIncidentParams{
+		Status: IncidentStatusCompleted,
+		Body:   body,
+	}
+	for _, component := range incident.Components {
+		if component.ID == nil || component.Status == nil ||
+			ComponentStatus(*component.Status) != ComponentStatusUnderMaintenance {
+			continue
+		}
+		if params.Components == nil {
+			params.Components = map[string]ComponentStatus{}
+		}
+		params.Components[*component.ID] = ComponentStatusOperational
+	}
+
+	return s.UpdateIncident(ctx, pageID, incidentID, params)
+}
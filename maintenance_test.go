@@ -0,0 +1,166 @@
+package statuspage
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestMaintenanceWindow_CreateIncidentParams(t *testing.T) {
+	end := referenceTime.Add(2 * time.Hour)
+	w := MaintenanceWindow{
+		Name:              "a",
+		Body:              "b",
+		Start:             referenceTime,
+		End:               end,
+		ComponentIDs:      []string{"c", "d"},
+		AutoTransition:    true,
+		NotifyAtStart:     true,
+		ReminderIntervals: []int{1, 24},
+	}
+
+	params, err := w.CreateIncidentParams()
+	if err != nil {
+		t.Fatalf("MaintenanceWindow.CreateIncidentParams returned error: %v", err)
+	}
+
+	testJSONMarshal(t, &params, `{
+		"name": "a",
+		"status": "scheduled",
+		"impact_override": "maintenance",
+		"body": "b",
+		"component_ids": ["c", "d"],
+		"scheduled_for": "2006-01-02T15:04:05Z",
+		"scheduled_until": "2006-01-02T17:04:05Z",
+		"scheduled_remind_prior": true,
+		"scheduled_auto_in_progress": true,
+		"scheduled_auto_completed": true,
+		"auto_transition_to_maintenance_state": true,
+		"auto_transition_to_operational_state": true,
+		"auto_transition_deliver_notifications_at_start": true,
+		"auto_transition_deliver_notifications_at_end": false,
+		"reminder_intervals": "[1, 24]"
+	}`)
+}
+
+func TestMaintenanceWindow_CreateIncidentParams_minimal(t *testing.T) {
+	w := MaintenanceWindow{Name: "a", Start: referenceTime, End: referenceTime.Add(time.Hour)}
+
+	params, err := w.CreateIncidentParams()
+	if err != nil {
+		t.Fatalf("MaintenanceWindow.CreateIncidentParams returned error: %v", err)
+	}
+
+	testJSONMarshal(t, &params, `{
+		"name": "a",
+		"status": "scheduled",
+		"impact_override": "maintenance",
+		"scheduled_for": "2006-01-02T15:04:05Z",
+		"scheduled_until": "2006-01-02T16:04:05Z"
+	}`)
+}
+
+func TestMaintenanceWindow_CreateIncidentParams_invalid(t *testing.T) {
+	tests := []MaintenanceWindow{
+		{Start: referenceTime, End: referenceTime.Add(time.Hour)},
+		{Name: "a", End: referenceTime},
+		{Name: "a", Start: referenceTime},
+		{Name: "a", Start: referenceTime, End: referenceTime},
+		{Name: "a", Start: referenceTime, End: referenceTime.Add(-time.Hour)},
+	}
+
+	for _, w := range tests {
+		if _, err := w.CreateIncidentParams(); err == nil {
+			t.Errorf("Expected error for maintenance window %+v", w)
+		}
+	}
+}
+
+func TestIncidentService_ScheduleMaintenance(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	window := MaintenanceWindow{
+		Name:         "a",
+		Start:        referenceTime,
+		End:          referenceTime.Add(time.Hour),
+		ComponentIDs: []string{"c"},
+	}
+
+	mux.HandleFunc("/v1/pages/1/incidents", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+
+		v := &CreateIncidentRequestBody{}
+		json.NewDecoder(r.Body).Decode(v)
+		want, _ := window.CreateIncidentParams()
+		if !reflect.DeepEqual(v.Incident, want) {
+			t.Errorf("Request body = %+v, want %+v", v.Incident, want)
+		}
+
+		fmt.Fprint(w, `{"id":"2", "status":"scheduled"}`)
+	})
+
+	incident, _, err := client.Incident.ScheduleMaintenance(context.Background(), "1", window)
+	if err != nil {
+		t.Errorf("IncidentService.ScheduleMaintenance returned error: %v", err)
+	}
+
+	want := &Incident{ID: String("2"), Status: String("scheduled")}
+	if !reflect.DeepEqual(incident, want) {
+		t.Errorf("IncidentService.ScheduleMaintenance returned %+v, want %+v", incident, want)
+	}
+}
+
+func TestIncidentService_ScheduleMaintenance_invalid(t *testing.T) {
+	client, _, _, teardown := setup()
+	defer teardown()
+
+	_, _, err := client.Incident.ScheduleMaintenance(context.Background(), "1", MaintenanceWindow{Name: "a"})
+	if err == nil {
+		t.Errorf("Expected error for incomplete maintenance window")
+	}
+}
+
+func TestIncidentService_CompleteMaintenance(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v1/pages/1/incidents/2", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "GET":
+			fmt.Fprint(w, `{"id":"2", "status":"in_progress", "components": [
+				{"id":"a", "status":"under_maintenance"},
+				{"id":"b", "status":"major_outage"}
+			]}`)
+		case "PATCH":
+			v := &UpdateIncidentRequestBody{}
+			json.NewDecoder(r.Body).Decode(v)
+			want := UpdateIncidentParams{
+				Status:     IncidentStatusCompleted,
+				Body:       "done",
+				Components: map[string]ComponentStatus{"a": ComponentStatusOperational},
+			}
+			if !reflect.DeepEqual(v.Incident, want) {
+				t.Errorf("Request body = %+v, want %+v", v.Incident, want)
+			}
+
+			fmt.Fprint(w, `{"id":"2", "status":"completed"}`)
+		default:
+			t.Errorf("Unexpected request method %v", r.Method)
+		}
+	})
+
+	incident, _, err := client.Incident.CompleteMaintenance(context.Background(), "1", "2", "done")
+	if err != nil {
+		t.Errorf("IncidentService.CompleteMaintenance returned error: %v", err)
+	}
+
+	want := &Incident{ID: String("2"), Status: String("completed")}
+	if !reflect.DeepEqual(incident, want) {
+		t.Errorf("IncidentService.CompleteMaintenance returned %+v, want %+v", incident, want)
+	}
+}
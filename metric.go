@@ -0,0 +1,388 @@
+package statuspage
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// MetricService handles communication with the metric and metrics provider
+// related methods of the Statuspage API.
+//
+// Statuspage API docs: https://developer.statuspage.io/#tag/metrics
+type MetricService service
+
+// MetricAPI is the interface implemented by MetricService.
+type MetricAPI interface {
+	ListMetricsProviders(ctx context.Context, pageID string, opts *ListOptions) (*[]MetricsProvider, *Response, error)
+	GetMetricsProvider(ctx context.Context, pageID string, providerID string) (*MetricsProvider, *Response, error)
+	CreateMetricsProvider(ctx context.Context, pageID string, provider CreateMetricsProviderParams) (*MetricsProvider, *Response, error)
+	UpdateMetricsProvider(ctx context.Context, pageID string, providerID string, provider UpdateMetricsProviderParams) (*MetricsProvider, *Response, error)
+	DeleteMetricsProvider(ctx context.Context, pageID string, providerID string) (*Response, error)
+	ListMetrics(ctx context.Context, pageID string, opts *ListOptions) (*[]Metric, *Response, error)
+	ListProviderMetrics(ctx context.Context, pageID string, providerID string, opts *ListOptions) (*[]Metric, *Response, error)
+	GetMetric(ctx context.Context, pageID string, metricID string) (*Metric, *Response, error)
+	CreateMetric(ctx context.Context, pageID string, providerID string, metric CreateMetricParams) (*Metric, *Response, error)
+	UpdateMetric(ctx context.Context, pageID string, metricID string, metric UpdateMetricParams) (*Metric, *Response, error)
+	DeleteMetric(ctx context.Context, pageID string, metricID string) (*Response, error)
+	SubmitDataPoint(ctx context.Context, pageID string, metricID string, point MetricDataPoint) (*Response, error)
+	SubmitDataPoints(ctx context.Context, pageID string, points map[string][]MetricDataPoint) (*Response, error)
+	ResetMetricData(ctx context.Context, pageID string, metricID string) (*Response, error)
+}
+
+var _ MetricAPI = (*MetricService)(nil)
+
+const (
+	// MaxMetricDataAge is how far in the past a data point may lie to be
+	// accepted by the Statuspage API.
+	MaxMetricDataAge = 28 * 24 * time.Hour
+
+	// MaxMetricDataClockSkew is how far in the future a data point may lie
+	// to account for clocks running slightly ahead.
+	MaxMetricDataClockSkew = 5 * time.Minute
+)
+
+// MetricsProvider is the Statuspage API metrics provider representation
+type MetricsProvider struct {
+	ID                *string    `json:"id,omitempty"`
+	PageID            *string    `json:"page_id,omitempty"`
+	Type              *string    `json:"type,omitempty"`
+	Disabled          *bool      `json:"disabled,omitempty"`
+	MetricBaseURI     *string    `json:"metric_base_uri,omitempty"`
+	LastRevalidatedAt *Timestamp `json:"last_revalidated_at,omitempty"`
+	CreatedAt         *Timestamp `json:"created_at,omitempty"`
+	UpdatedAt         *Timestamp `json:"updated_at,omitempty"`
+}
+
+func (p MetricsProvider) String() string {
+	return Stringify(p)
+}
+
+// Metric is the Statuspage API metric representation
+type Metric struct {
+	ID                 *string    `json:"id,omitempty"`
+	MetricsProviderID  *string    `json:"metrics_provider_id,omitempty"`
+	MetricIdentifier   *string    `json:"metric_identifier,omitempty"`
+	Name               *string    `json:"name,omitempty"`
+	Display            *bool      `json:"display,omitempty"`
+	TooltipDescription *string    `json:"tooltip_description,omitempty"`
+	Backfilled         *bool      `json:"backfilled,omitempty"`
+	YAxisMin           *float64   `json:"y_axis_min,omitempty"`
+	YAxisMax           *float64   `json:"y_axis_max,omitempty"`
+	YAxisHidden        *bool      `json:"y_axis_hidden,omitempty"`
+	Suffix             *string    `json:"suffix,omitempty"`
+	DecimalPlaces      *int32     `json:"decimal_places,omitempty"`
+	MostRecentDataAt   *Timestamp `json:"most_recent_data_at,omitempty"`
+	CreatedAt          *Timestamp `json:"created_at,omitempty"`
+	UpdatedAt          *Timestamp `json:"updated_at,omitempty"`
+	LastFetchedAt      *Timestamp `json:"last_fetched_at,omitempty"`
+	BackfillPercentage *int32     `json:"backfill_percentage,omitempty"`
+	ReferenceName      *string    `json:"reference_name,omitempty"`
+}
+
+func (m Metric) String() string {
+	return Stringify(m)
+}
+
+// MetricDataPoint is a single value of a metric. The API transfers its
+// Timestamp as Unix seconds.
+type MetricDataPoint struct {
+	Timestamp Timestamp `json:"timestamp"`
+	Value     float64   `json:"value"`
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (p MetricDataPoint) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Timestamp int64   `json:"timestamp"`
+		Value     float64 `json:"value"`
+	}{p.Timestamp.Unix(), p.Value})
+}
+
+// validate checks that the data point lies within the time range accepted by
+// the Statuspage API.
+func (p MetricDataPoint) validate(now time.Time) error {
+	if p.Timestamp.Before(now.Add(-MaxMetricDataAge)) {
+		return fmt.Errorf("metric data point at %v is older than %v", p.Timestamp, MaxMetricDataAge)
+	}
+	if p.Timestamp.After(now.Add(MaxMetricDataClockSkew)) {
+		return fmt.Errorf("metric data point at %v lies in the future", p.Timestamp)
+	}
+	return nil
+}
+
+// ListMetricsProviders returns a list of metrics providers for a given page id
+func (s *MetricService) ListMetricsProviders(ctx context.Context, pageID string, opts *ListOptions) (*[]MetricsProvider, *Response, error) {
+	path, err := addOptions("v1/pages/"+pageID+"/metrics_providers", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.newRequest("GET", path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var providers []MetricsProvider
+	resp, err := s.client.do(ctx, req, &providers)
+
+	return &providers, resp, err
+}
+
+// GetMetricsProvider returns metrics provider information for a given page and provider id
+func (s *MetricService) GetMetricsProvider(ctx context.Context, pageID string, providerID string) (*MetricsProvider, *Response, error) {
+	path := "v1/pages/" + pageID + "/metrics_providers/" + providerID
+	req, err := s.client.newRequest("GET", path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var provider MetricsProvider
+	resp, err := s.client.do(ctx, req, &provider)
+
+	return &provider, resp, err
+}
+
+// CreateMetricsProviderParams are the parameters that can be set using the create metrics provider API endpoint.
+// Which credentials are required depends on Type.
+type CreateMetricsProviderParams struct {
+	Type           string `json:"type,omitempty"`
+	Email          string `json:"email,omitempty"`
+	Password       string `json:"password,omitempty"`
+	APIKey         string `json:"api_key,omitempty"`
+	APIToken       string `json:"api_token,omitempty"`
+	ApplicationKey string `json:"application_key,omitempty"`
+	MetricBaseURI  string `json:"metric_base_uri,omitempty"`
+}
+
+// CreateMetricsProviderRequestBody is the create metrics provider request body representation
+type CreateMetricsProviderRequestBody struct {
+	MetricsProvider CreateMetricsProviderParams `json:"metrics_provider"`
+}
+
+// CreateMetricsProvider creates a metrics provider for a given page id
+func (s *MetricService) CreateMetricsProvider(ctx context.Context, pageID string, provider CreateMetricsProviderParams) (*MetricsProvider, *Response, error) {
+	path := "v1/pages/" + pageID + "/metrics_providers"
+	payload := CreateMetricsProviderRequestBody{MetricsProvider: provider}
+	req, err := s.client.newRequest("POST", path, payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var createdProvider MetricsProvider
+	resp, err := s.client.do(ctx, req, &createdProvider)
+
+	return &createdProvider, resp, err
+}
+
+// UpdateMetricsProviderParams are the parameters that can be changed using the update metrics provider API endpoint
+type UpdateMetricsProviderParams struct {
+	Type          string `json:"type,omitempty"`
+	MetricBaseURI string `json:"metric_base_uri,omitempty"`
+}
+
+// UpdateMetricsProviderRequestBody is the update metrics provider request body representation
+type UpdateMetricsProviderRequestBody struct {
+	MetricsProvider UpdateMetricsProviderParams `json:"metrics_provider"`
+}
+
+// UpdateMetricsProvider updates a metrics provider for a given page and provider id
+func (s *MetricService) UpdateMetricsProvider(ctx context.Context, pageID string, providerID string, provider UpdateMetricsProviderParams) (*MetricsProvider, *Response, error) {
+	path := "v1/pages/" + pageID + "/metrics_providers/" + providerID
+	payload := UpdateMetricsProviderRequestBody{MetricsProvider: provider}
+	req, err := s.client.newRequest("PATCH", path, payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var updatedProvider MetricsProvider
+	resp, err := s.client.do(ctx, req, &updatedProvider)
+
+	return &updatedProvider, resp, err
+}
+
+// DeleteMetricsProvider deletes a metrics provider for a given page and provider id
+func (s *MetricService) DeleteMetricsProvider(ctx context.Context, pageID string, providerID string) (*Response, error) {
+	path := "v1/pages/" + pageID + "/metrics_providers/" + providerID
+	req, err := s.client.newRequest("DELETE", path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.do(ctx, req, nil)
+}
+
+// ListMetrics returns a list of metrics for a given page id
+func (s *MetricService) ListMetrics(ctx context.Context, pageID string, opts *ListOptions) (*[]Metric, *Response, error) {
+	return s.listMetrics(ctx, "v1/pages/"+pageID+"/metrics", opts)
+}
+
+// ListProviderMetrics returns a list of metrics for a given page and provider id
+func (s *MetricService) ListProviderMetrics(ctx context.Context, pageID string, providerID string, opts *ListOptions) (*[]Metric, *Response, error) {
+	return s.listMetrics(ctx, "v1/pages/"+pageID+"/metrics_providers/"+providerID+"/metrics", opts)
+}
+
+func (s *MetricService) listMetrics(ctx context.Context, path string, opts *ListOptions) (*[]Metric, *Response, error) {
+	path, err := addOptions(path, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.newRequest("GET", path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var metrics []Metric
+	resp, err := s.client.do(ctx, req, &metrics)
+
+	return &metrics, resp, err
+}
+
+// GetMetric returns metric information for a given page and metric id
+func (s *MetricService) GetMetric(ctx context.Context, pageID string, metricID string) (*Metric, *Response, error) {
+	path := "v1/pages/" + pageID + "/metrics/" + metricID
+	req, err := s.client.newRequest("GET", path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var metric Metric
+	resp, err := s.client.do(ctx, req, &metric)
+
+	return &metric, resp, err
+}
+
+// CreateMetricParams are the parameters that can be set using the create metric API endpoint
+type CreateMetricParams struct {
+	Name               string   `json:"name,omitempty"`
+	MetricIdentifier   string   `json:"metric_identifier,omitempty"`
+	Transform          string   `json:"transform,omitempty"`
+	ApplicationID      string   `json:"application_id,omitempty"`
+	Suffix             string   `json:"suffix,omitempty"`
+	YAxisMin           *float64 `json:"y_axis_min,omitempty"`
+	YAxisMax           *float64 `json:"y_axis_max,omitempty"`
+	YAxisHidden        *bool    `json:"y_axis_hidden,omitempty"`
+	Display            *bool    `json:"display,omitempty"`
+	DecimalPlaces      *int32   `json:"decimal_places,omitempty"`
+	TooltipDescription string   `json:"tooltip_description,omitempty"`
+}
+
+// CreateMetricRequestBody is the create metric request body representation
+type CreateMetricRequestBody struct {
+	Metric CreateMetricParams `json:"metric"`
+}
+
+// CreateMetric creates a metric for a given page and provider id
+func (s *MetricService) CreateMetric(ctx context.Context, pageID string, providerID string, metric CreateMetricParams) (*Metric, *Response, error) {
+	path := "v1/pages/" + pageID + "/metrics_providers/" + providerID + "/metrics"
+	payload := CreateMetricRequestBody{Metric: metric}
+	req, err := s.client.newRequest("POST", path, payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var createdMetric Metric
+	resp, err := s.client.do(ctx, req, &createdMetric)
+
+	return &createdMetric, resp, err
+}
+
+// UpdateMetricParams are the parameters that can be changed using the update metric API endpoint
+type UpdateMetricParams struct {
+	Name             string `json:"name,omitempty"`
+	MetricIdentifier string `json:"metric_identifier,omitempty"`
+}
+
+// UpdateMetricRequestBody is the update metric request body representation
+type UpdateMetricRequestBody struct {
+	Metric UpdateMetricParams `json:"metric"`
+}
+
+// UpdateMetric updates a metric for a given page and metric id
+func (s *MetricService) UpdateMetric(ctx context.Context, pageID string, metricID string, metric UpdateMetricParams) (*Metric, *Response, error) {
+	path := "v1/pages/" + pageID + "/metrics/" + metricID
+	payload := UpdateMetricRequestBody{Metric: metric}
+	req, err := s.client.newRequest("PATCH", path, payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var updatedMetric Metric
+	resp, err := s.client.do(ctx, req, &updatedMetric)
+
+	return &updatedMetric, resp, err
+}
+
+// DeleteMetric deletes a metric for a given page and metric id
+func (s *MetricService) DeleteMetric(ctx context.Context, pageID string, metricID string) (*Response, error) {
+	path := "v1/pages/" + pageID + "/metrics/" + metricID
+	req, err := s.client.newRequest("DELETE", path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.do(ctx, req, nil)
+}
+
+// SubmitDataPointRequestBody is the submit metric data point request body representation
+type SubmitDataPointRequestBody struct {
+	Data MetricDataPoint `json:"data"`
+}
+
+// SubmitDataPoint adds a data point to a metric for a given page and metric id.
+// Points outside the range accepted by the API are rejected before sending.
+func (s *MetricService) SubmitDataPoint(ctx context.Context, pageID string, metricID string, point MetricDataPoint) (*Response, error) {
+	if err := point.validate(time.Now()); err != nil {
+		return nil, err
+	}
+
+	path := "v1/pages/" + pageID + "/metrics/" + metricID + "/data"
+	payload := SubmitDataPointRequestBody{Data: point}
+	req, err := s.client.newRequest("POST", path, payload)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.do(ctx, req, nil)
+}
+
+// SubmitDataPointsRequestBody is the submit metric data points request body representation
+type SubmitDataPointsRequestBody struct {
+	Data map[string][]MetricDataPoint `json:"data"`
+}
+
+// SubmitDataPoints adds data points to many metrics of a given page id in a
+// single call. points maps metric ids to their new data points. Points outside
+// the range accepted by the API are rejected before sending.
+func (s *MetricService) SubmitDataPoints(ctx context.Context, pageID string, points map[string][]MetricDataPoint) (*Response, error) {
+	now := time.Now()
+	for metricID, metricPoints := range points {
+		for _, point := range metricPoints {
+			if err := point.validate(now); err != nil {
+				return nil, fmt.Errorf("metric %s: %w", metricID, err)
+			}
+		}
+	}
+
+	path := "v1/pages/" + pageID + "/metrics/data"
+	payload := SubmitDataPointsRequestBody{Data: points}
+	req, err := s.client.newRequest("POST", path, payload)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.do(ctx, req, nil)
+}
+
+// ResetMetricData deletes all data points of a metric for a given page and metric id
+func (s *MetricService) ResetMetricData(ctx context.Context, pageID string, metricID string) (*Response, error) {
+	path := "v1/pages/" + pageID + "/metrics/" + metricID + "/data"
+	req, err := s.client.newRequest("DELETE", path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.do(ctx, req, nil)
+}
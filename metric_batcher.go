@@ -0,0 +1,315 @@
+package statuspage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+)
+
+const (
+	defaultMetricBatchSize     = 1000
+	defaultMetricFlushInterval = 10 * time.Second
+	defaultMetricFlushRetries  = 3
+)
+
+// ErrMetricBatcherClosed is returned by MetricBatcher.Add after the batcher
+// has been closed.
+var ErrMetricBatcherClosed = errors.New("metric batcher is closed")
+
+// MetricBatcherOptions configures a MetricBatcher. Zero values select the
+// defaults.
+type MetricBatcherOptions struct {
+	// MaxBatchSize is the number of buffered data points that triggers a
+	// flush ahead of the next interval, and the most data points submitted
+	// in one request. Defaults to 1000.
+	MaxBatchSize int
+
+	// FlushInterval is how often buffered data points are submitted.
+	// Defaults to 10 seconds.
+	FlushInterval time.Duration
+
+	// MaxRetries is how many times a batch that failed with a transient
+	// error is submitted again before its data points are dropped.
+	// Defaults to 3.
+	MaxRetries int
+
+	// OnError, if set, is called with the errors of background flushes.
+	// It must not block.
+	OnError func(error)
+}
+
+// MetricBatcher buffers metric data points of a page and submits them in
+// batches of at most MaxBatchSize points, each request covering all metrics.
+// Points are flushed every FlushInterval or as soon as MaxBatchSize points
+// are buffered.
+//
+// Batches that fail with a network error, 429 or 5xx are kept and submitted
+// again with the next flush. A MetricBatcher is safe for concurrent use by
+// multiple goroutines. Close must be called to flush the remaining points and
+// stop the background goroutine.
+type MetricBatcher struct {
+	metrics MetricDataPointsSubmitter
+	policy  *RetryPolicy
+	pageID  string
+	opts    MetricBatcherOptions
+
+	mu      sync.Mutex
+	pending map[string][]MetricDataPoint
+	size    int
+	closed  bool
+
+	// flushMu serializes flushes and guards failed and attempts.
+	flushMu  sync.Mutex
+	failed   map[string][]MetricDataPoint
+	attempts int
+
+	kick    chan struct{}
+	done    chan struct{}
+	stopped chan struct{}
+	ctx     context.Context
+	cancel  context.CancelFunc
+}
+
+// MetricDataPointsSubmitter submits data points of several metrics at once.
+// It is implemented by MetricService and every MetricAPI.
+type MetricDataPointsSubmitter interface {
+	SubmitDataPoints(ctx context.Context, pageID string, points map[string][]MetricDataPoint) (*Response, error)
+}
+
+// NewMetricBatcher returns a MetricBatcher submitting data points to the
+// metrics of a given page id through metrics, usually the Metric service of a
+// Client, and starts its background flushes. opts may be nil.
+func NewMetricBatcher(metrics MetricDataPointsSubmitter, pageID string, opts *MetricBatcherOptions) *MetricBatcher {
+	b := &MetricBatcher{
+		metrics: metrics,
+		policy:  DefaultRetryPolicy(),
+		pageID:  pageID,
+		pending: map[string][]MetricDataPoint{},
+		kick:    make(chan struct{}, 1),
+		done:    make(chan struct{}),
+		stopped: make(chan struct{}),
+	}
+	if opts != nil {
+		b.opts = *opts
+	}
+	if b.opts.MaxBatchSize <= 0 {
+		b.opts.MaxBatchSize = defaultMetricBatchSize
+	}
+	if b.opts.FlushInterval <= 0 {
+		b.opts.FlushInterval = defaultMetricFlushInterval
+	}
+	if b.opts.MaxRetries <= 0 {
+		b.opts.MaxRetries = defaultMetricFlushRetries
+	}
+	// Close retries like the client of a MetricService does.
+	if s, ok := metrics.(*MetricService); ok && s.client.RetryPolicy != nil {
+		b.policy = s.client.RetryPolicy
+	}
+	b.ctx, b.cancel = context.WithCancel(context.Background())
+
+	go b.run()
+
+	return b
+}
+
+// Add buffers a data point for a given metric id. Points outside the range
+// accepted by the API are rejected right away.
+func (b *MetricBatcher) Add(metricID string, point MetricDataPoint) error {
+	if err := point.validate(time.Now()); err != nil {
+		return err
+	}
+
+	b.mu.Lock()
+	if b.closed {
+		b.mu.Unlock()
+		return ErrMetricBatcherClosed
+	}
+	b.pending[metricID] = append(b.pending[metricID], point)
+	b.size++
+	full := b.size >= b.opts.MaxBatchSize
+	b.mu.Unlock()
+
+	if full {
+		select {
+		case b.kick <- struct{}{}:
+		default:
+		}
+	}
+	return nil
+}
+
+// Flush submits all buffered data points, including those of earlier failed
+// flushes, and returns the first error of the submissions, if any. Points
+// behind a batch kept for another attempt stay buffered.
+func (b *MetricBatcher) Flush(ctx context.Context) error {
+	return b.flush(ctx)
+}
+
+// Close stops the background flushes and submits the remaining data points,
+// retrying failed submissions until they succeed, MaxRetries is exhausted or
+// ctx is done. Points that could not be submitted are lost. Add returns
+// ErrMetricBatcherClosed once Close has been called.
+func (b *MetricBatcher) Close(ctx context.Context) error {
+	b.mu.Lock()
+	if b.closed {
+		b.mu.Unlock()
+		return nil
+	}
+	b.closed = true
+	b.mu.Unlock()
+
+	// Abort a background flush still in flight if ctx is done before it
+	// completes; its batch is kept and submitted again below.
+	close(b.done)
+	select {
+	case <-b.stopped:
+	case <-ctx.Done():
+		b.cancel()
+		<-b.stopped
+	}
+	b.cancel()
+
+	for attempt := 0; ; attempt++ {
+		err := b.flush(ctx)
+		if err == nil || !b.hasFailed() {
+			return err
+		}
+		if !sleepCtx(ctx, b.policy.backoff(attempt, nil)) {
+			return err
+		}
+	}
+}
+
+func (b *MetricBatcher) run() {
+	defer close(b.stopped)
+
+	ticker := time.NewTicker(b.opts.FlushInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-b.done:
+			return
+		case <-ticker.C:
+		case <-b.kick:
+		}
+
+		if err := b.flush(b.ctx); err != nil && b.opts.OnError != nil {
+			b.opts.OnError(err)
+		}
+	}
+}
+
+func (b *MetricBatcher) hasFailed() bool {
+	b.flushMu.Lock()
+	defer b.flushMu.Unlock()
+	return b.failed != nil
+}
+
+// flush submits the buffered data points in batches of at most MaxBatchSize
+// points. It stops at the first batch that is kept for another attempt,
+// leaving the remaining points buffered, and returns the first error.
+func (b *MetricBatcher) flush(ctx context.Context) error {
+	b.flushMu.Lock()
+	defer b.flushMu.Unlock()
+
+	var first error
+	for {
+		more, kept, err := b.flushBatch(ctx)
+		if first == nil {
+			first = err
+		}
+		if !more || kept {
+			return first
+		}
+	}
+}
+
+// flushBatch submits the failed batch topped up with buffered data points. It
+// reports whether points remain buffered and whether the batch was kept for
+// another attempt. flushMu must be held.
+func (b *MetricBatcher) flushBatch(ctx context.Context) (more, kept bool, err error) {
+	batch := b.failed
+	if batch == nil {
+		batch = map[string][]MetricDataPoint{}
+	}
+	room := b.opts.MaxBatchSize - countDataPoints(batch)
+
+	b.mu.Lock()
+	for metricID, points := range b.pending {
+		if room <= 0 {
+			break
+		}
+		n := min(len(points), room)
+		batch[metricID] = append(batch[metricID], points[:n]...)
+		if n == len(points) {
+			delete(b.pending, metricID)
+		} else {
+			b.pending[metricID] = points[n:]
+		}
+		b.size -= n
+		room -= n
+	}
+	more = b.size > 0
+	b.mu.Unlock()
+
+	// Points of batches retried for a long time may have aged out of the
+	// range accepted by the API, which would reject the whole batch.
+	cutoff := time.Now().Add(-MaxMetricDataAge)
+	for metricID, points := range batch {
+		kept := points[:0]
+		for _, point := range points {
+			if !point.Timestamp.Before(cutoff) {
+				kept = append(kept, point)
+			}
+		}
+		if len(kept) == 0 {
+			delete(batch, metricID)
+		} else {
+			batch[metricID] = kept
+		}
+	}
+	if len(batch) == 0 {
+		b.failed, b.attempts = nil, 0
+		return more, false, nil
+	}
+
+	_, err = b.metrics.SubmitDataPoints(ctx, b.pageID, batch)
+	if err == nil {
+		b.failed, b.attempts = nil, 0
+		return more, false, nil
+	}
+
+	b.attempts++
+	if !transientFlushError(err) || b.attempts > b.opts.MaxRetries {
+		b.failed, b.attempts = nil, 0
+		return more, false, fmt.Errorf("dropping %d metric data points: %w", countDataPoints(batch), err)
+	}
+	b.failed = batch
+	return more, true, err
+}
+
+// transientFlushError reports whether a failed batch may succeed when
+// submitted again: the request did not reach the API, was interrupted, or
+// was answered with 429 or 5xx.
+func transientFlushError(err error) bool {
+	var errResp *ErrorResponse
+	if errors.As(err, &errResp) {
+		return errResp.StatusCode == http.StatusTooManyRequests || errResp.StatusCode >= 500
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr) ||
+		errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded)
+}
+
+func countDataPoints(batch map[string][]MetricDataPoint) int {
+	n := 0
+	for _, points := range batch {
+		n += len(points)
+	}
+	return n
+}
@@ -0,0 +1,323 @@
+package statuspage
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+)
+
+// recordBatches registers a handler for the batched data endpoint of page 1
+// that records the submitted points and responds with the given status codes
+// in turn, then 201.
+func recordBatches(t *testing.T, mux *http.ServeMux, statuses ...int) (func() []map[string][]MetricDataPoint, <-chan struct{}) {
+	var mu sync.Mutex
+	var batches []map[string][]MetricDataPoint
+	received := make(chan struct{}, 100)
+
+	mux.HandleFunc("/v1/pages/1/metrics/data", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+
+		v := &SubmitDataPointsRequestBody{}
+		json.NewDecoder(r.Body).Decode(v)
+
+		mu.Lock()
+		batches = append(batches, v.Data)
+		status := http.StatusCreated
+		if len(statuses) > 0 {
+			status, statuses = statuses[0], statuses[1:]
+		}
+		mu.Unlock()
+
+		w.WriteHeader(status)
+		fmt.Fprint(w, `{}`)
+		received <- struct{}{}
+	})
+
+	return func() []map[string][]MetricDataPoint {
+		mu.Lock()
+		defer mu.Unlock()
+		return batches
+	}, received
+}
+
+func waitReceived(t *testing.T, received <-chan struct{}) {
+	t.Helper()
+	select {
+	case <-received:
+	case <-time.After(2 * time.Second):
+		t.Fatal("Timed out waiting for a batch")
+	}
+}
+
+func TestMetricBatcher_Close(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	batches, _ := recordBatches(t, mux)
+
+	b := NewMetricBatcher(client.Metric, "1", &MetricBatcherOptions{FlushInterval: time.Hour})
+
+	at := time.Now().Truncate(time.Second)
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			metricID := "m"
+			if i%2 == 1 {
+				metricID = "n"
+			}
+			if err := b.Add(metricID, MetricDataPoint{Timestamp: Timestamp{at}, Value: float64(i)}); err != nil {
+				t.Errorf("MetricBatcher.Add returned error: %v", err)
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	if err := b.Close(context.Background()); err != nil {
+		t.Fatalf("MetricBatcher.Close returned error: %v", err)
+	}
+
+	got := batches()
+	if len(got) != 1 {
+		t.Fatalf("Got %d batches, want 1", len(got))
+	}
+	if len(got[0]["m"]) != 5 || len(got[0]["n"]) != 5 {
+		t.Errorf("Batch = %+v, want 5 points for each metric", got[0])
+	}
+
+	if err := b.Add("m", MetricDataPoint{Timestamp: Timestamp{at}}); err != ErrMetricBatcherClosed {
+		t.Errorf("MetricBatcher.Add after Close returned %v, want %v", err, ErrMetricBatcherClosed)
+	}
+}
+
+func TestMetricBatcher_flushOnSize(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	batches, received := recordBatches(t, mux)
+
+	b := NewMetricBatcher(client.Metric, "1", &MetricBatcherOptions{MaxBatchSize: 2, FlushInterval: time.Hour})
+	defer b.Close(context.Background())
+
+	point := MetricDataPoint{Timestamp: Timestamp{time.Now()}, Value: 1}
+	b.Add("m", point)
+	b.Add("m", point)
+	waitReceived(t, received)
+
+	if got := batches(); len(got) != 1 || len(got[0]["m"]) != 2 {
+		t.Errorf("Batches = %+v, want one batch of 2 points", got)
+	}
+}
+
+func TestMetricBatcher_flushOnInterval(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	batches, received := recordBatches(t, mux)
+
+	b := NewMetricBatcher(client.Metric, "1", &MetricBatcherOptions{FlushInterval: 10 * time.Millisecond})
+	defer b.Close(context.Background())
+
+	b.Add("m", MetricDataPoint{Timestamp: Timestamp{time.Now()}, Value: 1})
+	waitReceived(t, received)
+
+	if got := batches(); len(got) != 1 || len(got[0]["m"]) != 1 {
+		t.Errorf("Batches = %+v, want one batch of 1 point", got)
+	}
+}
+
+func TestMetricBatcher_Flush_retry(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	batches, _ := recordBatches(t, mux, http.StatusServiceUnavailable)
+
+	b := NewMetricBatcher(client.Metric, "1", &MetricBatcherOptions{FlushInterval: time.Hour})
+	defer b.Close(context.Background())
+
+	point := MetricDataPoint{Timestamp: Timestamp{time.Now()}, Value: 1}
+	b.Add("m", point)
+	if err := b.Flush(context.Background()); err == nil {
+		t.Fatal("Expected error from failed flush")
+	}
+
+	b.Add("n", point)
+	if err := b.Flush(context.Background()); err != nil {
+		t.Fatalf("MetricBatcher.Flush returned error: %v", err)
+	}
+
+	got := batches()
+	if len(got) != 2 {
+		t.Fatalf("Got %d batches, want 2", len(got))
+	}
+	if len(got[1]["m"]) != 1 || len(got[1]["n"]) != 1 {
+		t.Errorf("Retried batch = %+v, want points of both metrics", got[1])
+	}
+}
+
+func TestMetricBatcher_Flush_maxRetries(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	batches, _ := recordBatches(t, mux, http.StatusBadGateway, http.StatusBadGateway)
+
+	b := NewMetricBatcher(client.Metric, "1", &MetricBatcherOptions{FlushInterval: time.Hour, MaxRetries: 1})
+	defer b.Close(context.Background())
+
+	b.Add("m", MetricDataPoint{Timestamp: Timestamp{time.Now()}, Value: 1})
+	b.Flush(context.Background())
+	if err := b.Flush(context.Background()); err == nil {
+		t.Fatal("Expected error once retries are exhausted")
+	}
+	if err := b.Flush(context.Background()); err != nil {
+		t.Fatalf("MetricBatcher.Flush returned error: %v", err)
+	}
+
+	if got := batches(); len(got) != 2 {
+		t.Errorf("Got %d batches, want 2", len(got))
+	}
+}
+
+func TestMetricBatcher_Flush_permanentError(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	batches, _ := recordBatches(t, mux, http.StatusUnprocessableEntity)
+
+	b := NewMetricBatcher(client.Metric, "1", &MetricBatcherOptions{FlushInterval: time.Hour})
+	defer b.Close(context.Background())
+
+	b.Add("m", MetricDataPoint{Timestamp: Timestamp{time.Now()}, Value: 1})
+	err := b.Flush(context.Background())
+	if !IsUnprocessable(err) {
+		t.Fatalf("MetricBatcher.Flush returned %v, want unprocessable error", err)
+	}
+	if err := b.Flush(context.Background()); err != nil {
+		t.Fatalf("MetricBatcher.Flush returned error: %v", err)
+	}
+
+	if got := batches(); len(got) != 1 {
+		t.Errorf("Got %d batches, want the rejected batch to be dropped", len(got))
+	}
+}
+
+// submitterFunc adapts a function to a MetricDataPointsSubmitter.
+type submitterFunc func(points map[string][]MetricDataPoint) error
+
+func (f submitterFunc) SubmitDataPoints(ctx context.Context, pageID string, points map[string][]MetricDataPoint) (*Response, error) {
+	return nil, f(points)
+}
+
+func TestMetricBatcher_maxBatchSize(t *testing.T) {
+	var mu sync.Mutex
+	var sizes []int
+	submit := submitterFunc(func(points map[string][]MetricDataPoint) error {
+		mu.Lock()
+		defer mu.Unlock()
+		sizes = append(sizes, countDataPoints(points))
+		return nil
+	})
+
+	b := NewMetricBatcher(submit, "1", &MetricBatcherOptions{MaxBatchSize: 2, FlushInterval: time.Hour})
+	point := MetricDataPoint{Timestamp: Timestamp{time.Now()}, Value: 1}
+	for _, metricID := range []string{"m", "m", "n", "m", "n"} {
+		b.Add(metricID, point)
+	}
+	if err := b.Close(context.Background()); err != nil {
+		t.Fatalf("MetricBatcher.Close returned error: %v", err)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	total := 0
+	for _, size := range sizes {
+		if size > 2 {
+			t.Errorf("Submitted a batch of %d points, want at most 2", size)
+		}
+		total += size
+	}
+	if total != 5 {
+		t.Errorf("Submitted %d points in batches %v, want 5", total, sizes)
+	}
+}
+
+func TestMetricBatcher_Flush_transientErrors(t *testing.T) {
+	tests := []struct {
+		err  error
+		kept bool
+	}{
+		{&url.Error{Op: "Post", URL: "https://api.statuspage.io", Err: errors.New("connection refused")}, true},
+		{context.DeadlineExceeded, true},
+		{&ErrorResponse{StatusCode: http.StatusTooManyRequests}, true},
+		{&ErrorResponse{StatusCode: http.StatusBadRequest}, false},
+		{errors.New("json: unsupported value: NaN"), false},
+	}
+
+	for _, tt := range tests {
+		calls := 0
+		submit := submitterFunc(func(points map[string][]MetricDataPoint) error {
+			calls++
+			if calls == 1 {
+				return tt.err
+			}
+			return nil
+		})
+
+		b := NewMetricBatcher(submit, "1", &MetricBatcherOptions{FlushInterval: time.Hour})
+		b.Add("m", MetricDataPoint{Timestamp: Timestamp{time.Now()}, Value: 1})
+		if err := b.Flush(context.Background()); !errors.Is(err, tt.err) {
+			t.Errorf("MetricBatcher.Flush returned %v, want %v", err, tt.err)
+		}
+		b.Flush(context.Background())
+		b.Close(context.Background())
+
+		if got := calls == 2; got != tt.kept {
+			t.Errorf("Batch failed with %v submitted again = %v, want %v", tt.err, got, tt.kept)
+		}
+	}
+}
+
+func TestMetricBatcher_OnError(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	recordBatches(t, mux, http.StatusUnprocessableEntity)
+
+	errc := make(chan error, 1)
+	b := NewMetricBatcher(client.Metric, "1", &MetricBatcherOptions{
+		FlushInterval: 10 * time.Millisecond,
+		OnError:       func(err error) { errc <- err },
+	})
+	defer b.Close(context.Background())
+
+	b.Add("m", MetricDataPoint{Timestamp: Timestamp{time.Now()}, Value: 1})
+
+	select {
+	case err := <-errc:
+		var errResp *ErrorResponse
+		if !errors.As(err, &errResp) {
+			t.Errorf("OnError called with %v, want *ErrorResponse", err)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("Timed out waiting for OnError")
+	}
+}
+
+func TestMetricBatcher_Add_outOfRange(t *testing.T) {
+	client, _, _, teardown := setup()
+	defer teardown()
+
+	b := NewMetricBatcher(client.Metric, "1", nil)
+	defer b.Close(context.Background())
+
+	if err := b.Add("m", MetricDataPoint{Timestamp: Timestamp{time.Now().Add(time.Hour)}}); err == nil {
+		t.Errorf("Expected error for data point in the future")
+	}
+}
@@ -0,0 +1,383 @@
+package statuspage
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestMetricDataPoint_marshal(t *testing.T) {
+	p := &MetricDataPoint{
+		Timestamp: Timestamp{time.Unix(1136214245, 0)},
+		Value:     1.5,
+	}
+	testJSONMarshal(t, p, `{"timestamp": 1136214245, "value": 1.5}`)
+}
+
+func TestMetricDataPoint_validate(t *testing.T) {
+	now := referenceTime
+	tests := []struct {
+		at    time.Time
+		valid bool
+	}{
+		{now, true},
+		{now.Add(-MaxMetricDataAge + time.Minute), true},
+		{now.Add(MaxMetricDataClockSkew), true},
+		{now.Add(-MaxMetricDataAge - time.Minute), false},
+		{now.Add(time.Hour), false},
+	}
+
+	for _, tt := range tests {
+		err := MetricDataPoint{Timestamp: Timestamp{tt.at}}.validate(now)
+		if (err == nil) != tt.valid {
+			t.Errorf("validate(%v) returned %v, want valid %v", tt.at, err, tt.valid)
+		}
+	}
+}
+
+func TestMetricService_ListMetricsProviders(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v1/pages/1/metrics_providers", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if got := r.URL.Query().Get("page"); got != "2" {
+			t.Errorf("page = %q, want %q", got, "2")
+		}
+		fmt.Fprint(w, `[{"id":"a", "type":"Datadog"}]`)
+	})
+
+	providers, _, err := client.Metric.ListMetricsProviders(context.Background(), "1", &ListOptions{Page: 2})
+	if err != nil {
+		t.Errorf("MetricService.ListMetricsProviders returned error: %v", err)
+	}
+
+	want := &[]MetricsProvider{{ID: String("a"), Type: String("Datadog")}}
+	if !reflect.DeepEqual(providers, want) {
+		t.Errorf("MetricService.ListMetricsProviders returned %+v, want %+v", providers, want)
+	}
+}
+
+func TestMetricService_GetMetricsProvider(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v1/pages/1/metrics_providers/a", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id":"a", "disabled":false}`)
+	})
+
+	provider, _, err := client.Metric.GetMetricsProvider(context.Background(), "1", "a")
+	if err != nil {
+		t.Errorf("MetricService.GetMetricsProvider returned error: %v", err)
+	}
+
+	want := &MetricsProvider{ID: String("a"), Disabled: Bool(false)}
+	if !reflect.DeepEqual(provider, want) {
+		t.Errorf("MetricService.GetMetricsProvider returned %+v, want %+v", provider, want)
+	}
+}
+
+func TestMetricService_CreateMetricsProvider(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	input := CreateMetricsProviderParams{Type: "Datadog", APIKey: "k", ApplicationKey: "ak"}
+
+	mux.HandleFunc("/v1/pages/1/metrics_providers", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+
+		v := &CreateMetricsProviderRequestBody{}
+		json.NewDecoder(r.Body).Decode(v)
+		if !reflect.DeepEqual(v.MetricsProvider, input) {
+			t.Errorf("Request body = %+v, want %+v", v.MetricsProvider, input)
+		}
+
+		fmt.Fprint(w, `{"id":"a"}`)
+	})
+
+	provider, _, err := client.Metric.CreateMetricsProvider(context.Background(), "1", input)
+	if err != nil {
+		t.Errorf("MetricService.CreateMetricsProvider returned error: %v", err)
+	}
+
+	want := &MetricsProvider{ID: String("a")}
+	if !reflect.DeepEqual(provider, want) {
+		t.Errorf("MetricService.CreateMetricsProvider returned %+v, want %+v", provider, want)
+	}
+}
+
+func TestMetricService_UpdateMetricsProvider(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	input := UpdateMetricsProviderParams{MetricBaseURI: "https://example.com"}
+
+	mux.HandleFunc("/v1/pages/1/metrics_providers/a", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+
+		v := &UpdateMetricsProviderRequestBody{}
+		json.NewDecoder(r.Body).Decode(v)
+		if !reflect.DeepEqual(v.MetricsProvider, input) {
+			t.Errorf("Request body = %+v, want %+v", v.MetricsProvider, input)
+		}
+
+		fmt.Fprint(w, `{"id":"a", "metric_base_uri":"https://example.com"}`)
+	})
+
+	provider, _, err := client.Metric.UpdateMetricsProvider(context.Background(), "1", "a", input)
+	if err != nil {
+		t.Errorf("MetricService.UpdateMetricsProvider returned error: %v", err)
+	}
+
+	want := &MetricsProvider{ID: String("a"), MetricBaseURI: String("https://example.com")}
+	if !reflect.DeepEqual(provider, want) {
+		t.Errorf("MetricService.UpdateMetricsProvider returned %+v, want %+v", provider, want)
+	}
+}
+
+func TestMetricService_DeleteMetricsProvider(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v1/pages/1/metrics_providers/a", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := client.Metric.DeleteMetricsProvider(context.Background(), "1", "a"); err != nil {
+		t.Errorf("MetricService.DeleteMetricsProvider returned error: %v", err)
+	}
+}
+
+func TestMetricService_ListMetrics(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v1/pages/1/metrics", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[{"id":"m", "name":"Latency"}]`)
+	})
+
+	metrics, _, err := client.Metric.ListMetrics(context.Background(), "1", nil)
+	if err != nil {
+		t.Errorf("MetricService.ListMetrics returned error: %v", err)
+	}
+
+	want := &[]Metric{{ID: String("m"), Name: String("Latency")}}
+	if !reflect.DeepEqual(metrics, want) {
+		t.Errorf("MetricService.ListMetrics returned %+v, want %+v", metrics, want)
+	}
+}
+
+func TestMetricService_ListProviderMetrics(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v1/pages/1/metrics_providers/a/metrics", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[{"id":"m", "metrics_provider_id":"a"}]`)
+	})
+
+	metrics, _, err := client.Metric.ListProviderMetrics(context.Background(), "1", "a", nil)
+	if err != nil {
+		t.Errorf("MetricService.ListProviderMetrics returned error: %v", err)
+	}
+
+	want := &[]Metric{{ID: String("m"), MetricsProviderID: String("a")}}
+	if !reflect.DeepEqual(metrics, want) {
+		t.Errorf("MetricService.ListProviderMetrics returned %+v, want %+v", metrics, want)
+	}
+}
+
+func TestMetricService_GetMetric(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v1/pages/1/metrics/m", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id":"m", "decimal_places":2, "y_axis_max":100.5}`)
+	})
+
+	metric, _, err := client.Metric.GetMetric(context.Background(), "1", "m")
+	if err != nil {
+		t.Errorf("MetricService.GetMetric returned error: %v", err)
+	}
+
+	yAxisMax := 100.5
+	want := &Metric{ID: String("m"), DecimalPlaces: Int32(2), YAxisMax: &yAxisMax}
+	if !reflect.DeepEqual(metric, want) {
+		t.Errorf("MetricService.GetMetric returned %+v, want %+v", metric, want)
+	}
+}
+
+func TestMetricService_CreateMetric(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	input := CreateMetricParams{Name: "Latency", MetricIdentifier: "api.latency", Suffix: "ms", Display: Bool(true)}
+
+	mux.HandleFunc("/v1/pages/1/metrics_providers/a/metrics", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+
+		v := &CreateMetricRequestBody{}
+		json.NewDecoder(r.Body).Decode(v)
+		if !reflect.DeepEqual(v.Metric, input) {
+			t.Errorf("Request body = %+v, want %+v", v.Metric, input)
+		}
+
+		fmt.Fprint(w, `{"id":"m"}`)
+	})
+
+	metric, _, err := client.Metric.CreateMetric(context.Background(), "1", "a", input)
+	if err != nil {
+		t.Errorf("MetricService.CreateMetric returned error: %v", err)
+	}
+
+	want := &Metric{ID: String("m")}
+	if !reflect.DeepEqual(metric, want) {
+		t.Errorf("MetricService.CreateMetric returned %+v, want %+v", metric, want)
+	}
+}
+
+func TestMetricService_UpdateMetric(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	input := UpdateMetricParams{Name: "p99 latency"}
+
+	mux.HandleFunc("/v1/pages/1/metrics/m", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+
+		v := &UpdateMetricRequestBody{}
+		json.NewDecoder(r.Body).Decode(v)
+		if !reflect.DeepEqual(v.Metric, input) {
+			t.Errorf("Request body = %+v, want %+v", v.Metric, input)
+		}
+
+		fmt.Fprint(w, `{"id":"m", "name":"p99 latency"}`)
+	})
+
+	metric, _, err := client.Metric.UpdateMetric(context.Background(), "1", "m", input)
+	if err != nil {
+		t.Errorf("MetricService.UpdateMetric returned error: %v", err)
+	}
+
+	want := &Metric{ID: String("m"), Name: String("p99 latency")}
+	if !reflect.DeepEqual(metric, want) {
+		t.Errorf("MetricService.UpdateMetric returned %+v, want %+v", metric, want)
+	}
+}
+
+func TestMetricService_DeleteMetric(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v1/pages/1/metrics/m", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := client.Metric.DeleteMetric(context.Background(), "1", "m"); err != nil {
+		t.Errorf("MetricService.DeleteMetric returned error: %v", err)
+	}
+}
+
+func TestMetricService_SubmitDataPoint(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	at := time.Now().Add(-time.Minute).Truncate(time.Second)
+
+	mux.HandleFunc("/v1/pages/1/metrics/m/data", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+
+		var v map[string]map[string]float64
+		json.NewDecoder(r.Body).Decode(&v)
+		want := map[string]map[string]float64{"data": {"timestamp": float64(at.Unix()), "value": 42}}
+		if !reflect.DeepEqual(v, want) {
+			t.Errorf("Request body = %+v, want %+v", v, want)
+		}
+
+		fmt.Fprint(w, `{"data":{}}`)
+	})
+
+	point := MetricDataPoint{Timestamp: Timestamp{at}, Value: 42}
+	if _, err := client.Metric.SubmitDataPoint(context.Background(), "1", "m", point); err != nil {
+		t.Errorf("MetricService.SubmitDataPoint returned error: %v", err)
+	}
+}
+
+func TestMetricService_SubmitDataPoint_outOfRange(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v1/pages/1/metrics/m/data", func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("Unexpected request for out of range data point")
+	})
+
+	point := MetricDataPoint{Timestamp: Timestamp{time.Now().Add(-MaxMetricDataAge - time.Hour)}}
+	if _, err := client.Metric.SubmitDataPoint(context.Background(), "1", "m", point); err == nil {
+		t.Errorf("Expected error for data point older than %v", MaxMetricDataAge)
+	}
+}
+
+func TestMetricService_SubmitDataPoints(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	at := time.Now().Add(-time.Minute).Truncate(time.Second)
+	input := map[string][]MetricDataPoint{
+		"m": {{Timestamp: Timestamp{at}, Value: 1}, {Timestamp: Timestamp{at.Add(time.Second)}, Value: 2}},
+		"n": {{Timestamp: Timestamp{at}, Value: 3}},
+	}
+
+	mux.HandleFunc("/v1/pages/1/metrics/data", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+
+		v := &SubmitDataPointsRequestBody{}
+		json.NewDecoder(r.Body).Decode(v)
+		if len(v.Data) != 2 || len(v.Data["m"]) != 2 || len(v.Data["n"]) != 1 {
+			t.Fatalf("Request body = %+v, want %+v", v.Data, input)
+		}
+		if got := v.Data["m"][1]; !got.Timestamp.Time.Equal(at.Add(time.Second)) || got.Value != 2 {
+			t.Errorf("Request body data point = %+v, want %+v", got, input["m"][1])
+		}
+
+		fmt.Fprint(w, `{}`)
+	})
+
+	if _, err := client.Metric.SubmitDataPoints(context.Background(), "1", input); err != nil {
+		t.Errorf("MetricService.SubmitDataPoints returned error: %v", err)
+	}
+}
+
+func TestMetricService_SubmitDataPoints_outOfRange(t *testing.T) {
+	client, _, _, teardown := setup()
+	defer teardown()
+
+	input := map[string][]MetricDataPoint{
+		"m": {{Timestamp: Timestamp{time.Now().Add(time.Hour)}}},
+	}
+	if _, err := client.Metric.SubmitDataPoints(context.Background(), "1", input); err == nil {
+		t.Errorf("Expected error for data point in the future")
+	}
+}
+
+func TestMetricService_ResetMetricData(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v1/pages/1/metrics/m/data", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := client.Metric.ResetMetricData(context.Background(), "1", "m"); err != nil {
+		t.Errorf("MetricService.ResetMetricData returned error: %v", err)
+	}
+}
@@ -0,0 +1,76 @@
+package statuspage
+
+import (
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// An Option configures a Client created by NewClient.
+type Option func(*Client)
+
+// WithHTTPClient sets the HTTP client used to send requests. If httpClient is
+// nil, http.DefaultClient is used.
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(c *Client) {
+		if httpClient == nil {
+			httpClient = http.DefaultClient
+		}
+		c.httpClient = httpClient
+	}
+}
+
+// WithBaseURL sets the base URL for API requests, e.g. to talk to a proxy or
+// a fake server in tests. A trailing slash is added to the path if missing so
+// that API paths are resolved relative to it.
+func WithBaseURL(baseURL *url.URL) Option {
+	return func(c *Client) {
+		u := *baseURL
+		if !strings.HasSuffix(u.Path, "/") {
+			u.Path += "/"
+		}
+		c.BaseURL = &u
+	}
+}
+
+// WithUserAgent sets the User-Agent header sent with every request.
+func WithUserAgent(userAgent string) Option {
+	return func(c *Client) {
+		c.UserAgent = userAgent
+	}
+}
+
+// WithTimeout sets a time limit for every request attempt, including reading
+// the response body. It applies to the HTTP client set with WithHTTPClient
+// regardless of the order of the options. The HTTP client is copied, so a
+// shared client such as http.DefaultClient is left untouched.
+func WithTimeout(timeout time.Duration) Option {
+	return func(c *Client) {
+		c.timeout = timeout
+	}
+}
+
+// WithRetryPolicy sets the policy for retrying rate limited and transiently
+// failing requests. See RetryPolicy.
+func WithRetryPolicy(policy *RetryPolicy) Option {
+	return func(c *Client) {
+		c.RetryPolicy = policy
+	}
+}
+
+// WithRateLimiter sets the limiter pacing all requests made by the client.
+// See SharedRateLimiter for a limiter shared by all clients using one token.
+func WithRateLimiter(limiter RateLimiter) Option {
+	return func(c *Client) {
+		c.RateLimiter = limiter
+	}
+}
+
+// WithLogger sets the logger that requests and retries are reported to.
+func WithLogger(logger *slog.Logger) Option {
+	return func(c *Client) {
+		c.Logger = logger
+	}
+}
@@ -0,0 +1,121 @@
+package statuspage
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestNewClient_defaults(t *testing.T) {
+	c := NewClient("a", nil)
+
+	if got, want := c.BaseURL.String(), "https://"+hostURL; got != want {
+		t.Errorf("NewClient BaseURL is %v, want %v", got, want)
+	}
+	if c.httpClient != http.DefaultClient {
+		t.Errorf("NewClient httpClient is %v, want http.DefaultClient", c.httpClient)
+	}
+	if c.Token != "a" {
+		t.Errorf("NewClient Token is %v, want %v", c.Token, "a")
+	}
+	if c.RetryPolicy != nil || c.RateLimiter != nil || c.Logger != nil {
+		t.Errorf("NewClient should not enable retries, rate limiting or logging by default")
+	}
+}
+
+func TestNewClient_options(t *testing.T) {
+	httpClient := &http.Client{}
+	baseURL, _ := url.Parse("https://example.com/api")
+	policy := DefaultRetryPolicy()
+	limiter := NewRateLimiter(1, 1)
+	logger := slog.Default()
+
+	c := NewClient("a",
+		WithHTTPClient(httpClient),
+		WithBaseURL(baseURL),
+		WithUserAgent("b"),
+		WithRetryPolicy(policy),
+		WithRateLimiter(limiter),
+		WithLogger(logger),
+	)
+
+	if c.httpClient != httpClient {
+		t.Errorf("WithHTTPClient was not applied")
+	}
+	if got, want := c.BaseURL.String(), "https://example.com/api/"; got != want {
+		t.Errorf("WithBaseURL set %v, want %v", got, want)
+	}
+	if baseURL.Path != "/api" {
+		t.Errorf("WithBaseURL modified its argument")
+	}
+	if c.UserAgent != "b" {
+		t.Errorf("WithUserAgent set %v, want %v", c.UserAgent, "b")
+	}
+	if c.RetryPolicy != policy {
+		t.Errorf("WithRetryPolicy was not applied")
+	}
+	if c.RateLimiter != limiter {
+		t.Errorf("WithRateLimiter was not applied")
+	}
+	if c.Logger != logger {
+		t.Errorf("WithLogger was not applied")
+	}
+}
+
+func TestWithTimeout(t *testing.T) {
+	c := NewClient("a", WithTimeout(time.Second))
+
+	if c.httpClient == http.DefaultClient {
+		t.Errorf("WithTimeout should not modify http.DefaultClient")
+	}
+	if c.httpClient.Timeout != time.Second {
+		t.Errorf("WithTimeout set %v, want %v", c.httpClient.Timeout, time.Second)
+	}
+	if http.DefaultClient.Timeout != 0 {
+		t.Errorf("WithTimeout modified http.DefaultClient")
+	}
+
+	hc := &http.Client{}
+	for _, opts := range [][]Option{
+		{WithTimeout(time.Second), WithHTTPClient(hc)},
+		{WithHTTPClient(hc), WithTimeout(time.Second)},
+	} {
+		c := NewClient("a", opts...)
+		if c.httpClient.Timeout != time.Second {
+			t.Errorf("WithTimeout set %v with WithHTTPClient, want %v", c.httpClient.Timeout, time.Second)
+		}
+	}
+	if hc.Timeout != 0 {
+		t.Errorf("WithTimeout modified the client passed to WithHTTPClient")
+	}
+}
+
+func TestClient_userAgentAndLogger(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	var buf bytes.Buffer
+	client.UserAgent = "b"
+	client.Logger = slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+
+	mux.HandleFunc("/v1/pages/1", func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("User-Agent"); got != "b" {
+			t.Errorf("User-Agent header is %v, want %v", got, "b")
+		}
+		fmt.Fprint(w, `{"id":"1"}`)
+	})
+
+	if _, _, err := client.Page.GetPage(context.Background(), "1"); err != nil {
+		t.Fatalf("PageService.GetPage returned error: %v", err)
+	}
+
+	if !strings.Contains(buf.String(), "request completed") {
+		t.Errorf("Expected request to be logged, got %q", buf.String())
+	}
+}
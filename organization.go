@@ -0,0 +1,168 @@
+package statuspage
+
+import (
+	"context"
+	"iter"
+)
+
+// OrganizationService handles communication with the organization user and
+// permission related methods of the Statuspage API.
+//
+// Statuspage API docs: https://developer.statuspage.io/#tag/users
+type OrganizationService service
+
+// OrganizationAPI is the interface implemented by OrganizationService.
+type OrganizationAPI interface {
+	ListUsers(ctx context.Context, organizationID string, opts *ListOptions) (*[]OrganizationUser, *Response, error)
+	AllUsers(ctx context.Context, organizationID string) iter.Seq2[OrganizationUser, error]
+	CreateUser(ctx context.Context, organizationID string, user CreateUserParams) (*OrganizationUser, *Response, error)
+	DeleteUser(ctx context.Context, organizationID string, userID string) (*Response, error)
+	GetPermissions(ctx context.Context, organizationID string, userID string) (*Permissions, *Response, error)
+	UpdatePermissions(ctx context.Context, organizationID string, userID string, permissions UpdatePermissionsParams) (*Permissions, *Response, error)
+}
+
+var _ OrganizationAPI = (*OrganizationService)(nil)
+
+// OrganizationUser is the Statuspage API organization user representation
+type OrganizationUser struct {
+	ID             *string    `json:"id,omitempty"`
+	OrganizationID *string    `json:"organization_id,omitempty"`
+	Email          *string    `json:"email,omitempty"`
+	FirstName      *string    `json:"first_name,omitempty"`
+	LastName       *string    `json:"last_name,omitempty"`
+	CreatedAt      *Timestamp `json:"created_at,omitempty"`
+	UpdatedAt      *Timestamp `json:"updated_at,omitempty"`
+}
+
+func (u OrganizationUser) String() string {
+	return Stringify(u)
+}
+
+// PagePermission is the Statuspage API representation of a user's role on a page
+type PagePermission struct {
+	PageID             *string `json:"page_id,omitempty"`
+	PageConfiguration  *bool   `json:"page_configuration,omitempty"`
+	IncidentManager    *bool   `json:"incident_manager,omitempty"`
+	MaintenanceManager *bool   `json:"maintenance_manager,omitempty"`
+}
+
+func (p PagePermission) String() string {
+	return Stringify(p)
+}
+
+// Permissions is the Statuspage API representation of a user's permissions
+// on the pages of an organization
+type Permissions struct {
+	UserID *string          `json:"user_id,omitempty"`
+	Pages  []PagePermission `json:"pages,omitempty"`
+}
+
+func (p Permissions) String() string {
+	return Stringify(p)
+}
+
+// ListUsers returns a list of users for a given organization id
+func (s *OrganizationService) ListUsers(ctx context.Context, organizationID string, opts *ListOptions) (*[]OrganizationUser, *Response, error) {
+	path, err := addOptions("v1/organizations/"+organizationID+"/users", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.newRequest("GET", path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var users []OrganizationUser
+	resp, err := s.client.do(ctx, req, &users)
+
+	return &users, resp, err
+}
+
+// AllUsers returns an iterator over all users for a given organization id,
+// fetching further result pages as needed
+func (s *OrganizationService) AllUsers(ctx context.Context, organizationID string) iter.Seq2[OrganizationUser, error] {
+	return allPages(ctx, func(ctx context.Context, opts *ListOptions) (*[]OrganizationUser, *Response, error) {
+		return s.ListUsers(ctx, organizationID, opts)
+	})
+}
+
+// CreateUserParams are the parameters that can be set using the create user API endpoint
+type CreateUserParams struct {
+	Email     string `json:"email,omitempty"`
+	Password  string `json:"password,omitempty"`
+	FirstName string `json:"first_name,omitempty"`
+	LastName  string `json:"last_name,omitempty"`
+}
+
+// CreateUserRequestBody is the create user request body representation
+type CreateUserRequestBody struct {
+	User CreateUserParams `json:"user"`
+}
+
+// CreateUser creates a user for a given organization id
+func (s *OrganizationService) CreateUser(ctx context.Context, organizationID string, user CreateUserParams) (*OrganizationUser, *Response, error) {
+	path := "v1/organizations/" + organizationID + "/users"
+	payload := CreateUserRequestBody{User: user}
+	req, err := s.client.newRequest("POST", path, payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var createdUser OrganizationUser
+	resp, err := s.client.do(ctx, req, &createdUser)
+
+	return &createdUser, resp, err
+}
+
+// DeleteUser deletes a user for a given organization and user id
+func (s *OrganizationService) DeleteUser(ctx context.Context, organizationID string, userID string) (*Response, error) {
+	path := "v1/organizations/" + organizationID + "/users/" + userID
+	req, err := s.client.newRequest("DELETE", path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.do(ctx, req, nil)
+}
+
+// GetPermissions returns the page permissions of a user for a given organization and user id
+func (s *OrganizationService) GetPermissions(ctx context.Context, organizationID string, userID string) (*Permissions, *Response, error) {
+	path := "v1/organizations/" + organizationID + "/permissions/" + userID
+	req, err := s.client.newRequest("GET", path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var permissions Permissions
+	resp, err := s.client.do(ctx, req, &permissions)
+
+	return &permissions, resp, err
+}
+
+// PagePermissionParams are the roles that can be granted to a user on a page
+type PagePermissionParams struct {
+	PageConfiguration  bool `json:"page_configuration"`
+	IncidentManager    bool `json:"incident_manager"`
+	MaintenanceManager bool `json:"maintenance_manager"`
+}
+
+// UpdatePermissionsParams are the parameters that can be changed using the update permissions API endpoint.
+// Pages maps page ids to the roles of the user on that page.
+type UpdatePermissionsParams struct {
+	Pages map[string]PagePermissionParams `json:"pages"`
+}
+
+// UpdatePermissions updates the page permissions of a user for a given organization and user id
+func (s *OrganizationService) UpdatePermissions(ctx context.Context, organizationID string, userID string, permissions UpdatePermissionsParams) (*Permissions, *Response, error) {
+	path := "v1/organizations/" + organizationID + "/permissions/" + userID
+	req, err := s.client.newRequest("PATCH", path, permissions)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var updatedPermissions Permissions
+	resp, err := s.client.do(ctx, req, &updatedPermissions)
+
+	return &updatedPermissions, resp, err
+}
@@ -0,0 +1,165 @@
+package statuspage
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestOrganizationService_ListUsers(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v1/organizations/o/users", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if got := r.URL.Query().Get("page"); got != "3" {
+			t.Errorf("page = %q, want %q", got, "3")
+		}
+		fmt.Fprint(w, `[{"id":"u", "email":"a@example.com", "first_name":"A"}]`)
+	})
+
+	users, _, err := client.Organization.ListUsers(context.Background(), "o", &ListOptions{Page: 3})
+	if err != nil {
+		t.Errorf("OrganizationService.ListUsers returned error: %v", err)
+	}
+
+	want := &[]OrganizationUser{{ID: String("u"), Email: String("a@example.com"), FirstName: String("A")}}
+	if !reflect.DeepEqual(users, want) {
+		t.Errorf("OrganizationService.ListUsers returned %+v, want %+v", users, want)
+	}
+}
+
+func TestOrganizationService_AllUsers(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v1/organizations/o/users", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[{"id":"u"}, {"id":"v"}]`)
+	})
+
+	var ids []string
+	for user, err := range client.Organization.AllUsers(context.Background(), "o") {
+		if err != nil {
+			t.Fatalf("OrganizationService.AllUsers returned error: %v", err)
+		}
+		ids = append(ids, *user.ID)
+	}
+
+	if want := []string{"u", "v"}; !reflect.DeepEqual(ids, want) {
+		t.Errorf("OrganizationService.AllUsers yielded %v, want %v", ids, want)
+	}
+}
+
+func TestOrganizationService_CreateUser(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	input := CreateUserParams{Email: "a@example.com", Password: "secret", FirstName: "A", LastName: "B"}
+
+	mux.HandleFunc("/v1/organizations/o/users", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+
+		v := &CreateUserRequestBody{}
+		json.NewDecoder(r.Body).Decode(v)
+		if !reflect.DeepEqual(v.User, input) {
+			t.Errorf("Request body = %+v, want %+v", v.User, input)
+		}
+
+		fmt.Fprint(w, `{"id":"u", "organization_id":"o"}`)
+	})
+
+	user, _, err := client.Organization.CreateUser(context.Background(), "o", input)
+	if err != nil {
+		t.Errorf("OrganizationService.CreateUser returned error: %v", err)
+	}
+
+	want := &OrganizationUser{ID: String("u"), OrganizationID: String("o")}
+	if !reflect.DeepEqual(user, want) {
+		t.Errorf("OrganizationService.CreateUser returned %+v, want %+v", user, want)
+	}
+}
+
+func TestOrganizationService_DeleteUser(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v1/organizations/o/users/u", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		fmt.Fprint(w, `{"id":"u"}`)
+	})
+
+	if _, err := client.Organization.DeleteUser(context.Background(), "o", "u"); err != nil {
+		t.Errorf("OrganizationService.DeleteUser returned error: %v", err)
+	}
+}
+
+func TestOrganizationService_GetPermissions(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v1/organizations/o/permissions/u", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"user_id":"u", "pages":[
+			{"page_id":"p", "page_configuration":true, "incident_manager":true, "maintenance_manager":false}
+		]}`)
+	})
+
+	permissions, _, err := client.Organization.GetPermissions(context.Background(), "o", "u")
+	if err != nil {
+		t.Errorf("OrganizationService.GetPermissions returned error: %v", err)
+	}
+
+	want := &Permissions{
+		UserID: String("u"),
+		Pages: []PagePermission{{
+			PageID:             String("p"),
+			PageConfiguration:  Bool(true),
+			IncidentManager:    Bool(true),
+			MaintenanceManager: Bool(false),
+		}},
+	}
+	if !reflect.DeepEqual(permissions, want) {
+		t.Errorf("OrganizationService.GetPermissions returned %+v, want %+v", permissions, want)
+	}
+}
+
+func TestOrganizationService_UpdatePermissions(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	input := UpdatePermissionsParams{Pages: map[string]PagePermissionParams{
+		"p": {IncidentManager: true},
+	}}
+
+	mux.HandleFunc("/v1/organizations/o/permissions/u", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+
+		var v map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&v)
+		want := map[string]interface{}{"pages": map[string]interface{}{
+			"p": map[string]interface{}{
+				"page_configuration":  false,
+				"incident_manager":    true,
+				"maintenance_manager": false,
+			},
+		}}
+		if !reflect.DeepEqual(v, want) {
+			t.Errorf("Request body = %+v, want %+v", v, want)
+		}
+
+		fmt.Fprint(w, `{"user_id":"u", "pages":[{"page_id":"p", "incident_manager":true}]}`)
+	})
+
+	permissions, _, err := client.Organization.UpdatePermissions(context.Background(), "o", "u", input)
+	if err != nil {
+		t.Errorf("OrganizationService.UpdatePermissions returned error: %v", err)
+	}
+
+	want := &Permissions{UserID: String("u"), Pages: []PagePermission{{PageID: String("p"), IncidentManager: Bool(true)}}}
+	if !reflect.DeepEqual(permissions, want) {
+		t.Errorf("OrganizationService.UpdatePermissions returned %+v, want %+v", permissions, want)
+	}
+}
@@ -2,6 +2,7 @@ package statuspage
 
 import (
 	"context"
+	"iter"
 )
 
 // PageService handles communication with the page related methods
@@ -10,6 +11,16 @@ import (
 // Statuspage API docs: https://developer.statuspage.io/#tag/pages
 type PageService service
 
+// PageAPI is the interface implemented by PageService.
+type PageAPI interface {
+	ListPages(ctx context.Context, opts *ListOptions) (*[]Page, *Response, error)
+	All(ctx context.Context) iter.Seq2[Page, error]
+	UpdatePage(ctx context.Context, pageID string, page UpdatePageParams) (*Page, *Response, error)
+	GetPage(ctx context.Context, pageID string) (*Page, *Response, error)
+}
+
+var _ PageAPI = (*PageService)(nil)
+
 // PageLogo is the Statuspage API page logo representation
 type PageLogo struct {
 	UpdatedAt *Timestamp `json:"updated_at,omitempty"`
@@ -69,18 +80,30 @@ func (p Page) String() string {
 	return Stringify(p)
 }
 
-// ListPages returns a list of all pages
-func (s *PageService) ListPages(ctx context.Context) (*[]Page, error) {
-	path := "v1/pages"
+// ListPages returns a list of pages
+func (s *PageService) ListPages(ctx context.Context, opts *ListOptions) (*[]Page, *Response, error) {
+	path, err := addOptions("v1/pages", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	req, err := s.client.newRequest("GET", path, nil)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	var pages []Page
-	_, err = s.client.do(ctx, req, &pages)
+	resp, err := s.client.do(ctx, req, &pages)
+
+	return &pages, resp, err
+}
 
-	return &pages, err
+// All returns an iterator over all pages, fetching further result pages as
+// needed
+func (s *PageService) All(ctx context.Context) iter.Seq2[Page, error] {
+	return allPages(ctx, func(ctx context.Context, opts *ListOptions) (*[]Page, *Response, error) {
+		return s.ListPages(ctx, opts)
+	})
 }
 
 // UpdatePageParams are the parameters that can be changed using the update page API endpoint
@@ -120,30 +143,30 @@ type UpdatePageRequestBody struct {
 }
 
 // UpdatePage updates page information for a given page id
-func (s *PageService) UpdatePage(ctx context.Context, pageID string, page UpdatePageParams) (*Page, error) {
+func (s *PageService) UpdatePage(ctx context.Context, pageID string, page UpdatePageParams) (*Page, *Response, error) {
 	path := "v1/pages/" + pageID
 	payload := UpdatePageRequestBody{Page: page}
 	req, err := s.client.newRequest("PATCH", path, payload)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	var updatedPage Page
-	_, err = s.client.do(ctx, req, &updatedPage)
+	resp, err := s.client.do(ctx, req, &updatedPage)
 
-	return &updatedPage, err
+	return &updatedPage, resp, err
 }
 
 // GetPage returns the page information for a given page id
-func (s *PageService) GetPage(ctx context.Context, pageID string) (*Page, error) {
+func (s *PageService) GetPage(ctx context.Context, pageID string) (*Page, *Response, error) {
 	path := "v1/pages/" + pageID
 	req, err := s.client.newRequest("GET", path, nil)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	var page Page
-	_, err = s.client.do(ctx, req, &page)
+	resp, err := s.client.do(ctx, req, &page)
 
-	return &page, err
+	return &page, resp, err
 }
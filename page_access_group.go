@@ -0,0 +1,215 @@
+package statuspage
+
+import (
+	"context"
+	"iter"
+)
+
+// PageAccessGroupService handles communication with the page access group
+// related methods of the Statuspage API. Page access groups grant their
+// users access to a set of components and metrics of an audience-specific
+// page.
+//
+// Statuspage API docs: https://developer.statuspage.io/#tag/page-access-groups
+type PageAccessGroupService service
+
+// PageAccessGroupAPI is the interface implemented by PageAccessGroupService.
+type PageAccessGroupAPI interface {
+	ListPageAccessGroups(ctx context.Context, pageID string, opts *ListOptions) (*[]PageAccessGroup, *Response, error)
+	All(ctx context.Context, pageID string) iter.Seq2[PageAccessGroup, error]
+	GetPageAccessGroup(ctx context.Context, pageID string, groupID string) (*PageAccessGroup, *Response, error)
+	CreatePageAccessGroup(ctx context.Context, pageID string, group CreatePageAccessGroupParams) (*PageAccessGroup, *Response, error)
+	UpdatePageAccessGroup(ctx context.Context, pageID string, groupID string, group UpdatePageAccessGroupParams) (*PageAccessGroup, *Response, error)
+	DeletePageAccessGroup(ctx context.Context, pageID string, groupID string) (*Response, error)
+	ListComponents(ctx context.Context, pageID string, groupID string, opts *ListOptions) (*[]Component, *Response, error)
+	AddComponents(ctx context.Context, pageID string, groupID string, componentIDs []string) (*PageAccessGroup, *Response, error)
+	ReplaceComponents(ctx context.Context, pageID string, groupID string, componentIDs []string) (*PageAccessGroup, *Response, error)
+	RemoveComponents(ctx context.Context, pageID string, groupID string, componentIDs []string) (*PageAccessGroup, *Response, error)
+	RemoveComponent(ctx context.Context, pageID string, groupID string, componentID string) (*Response, error)
+}
+
+var _ PageAccessGroupAPI = (*PageAccessGroupService)(nil)
+
+// PageAccessGroup is the Statuspage API page access group representation
+type PageAccessGroup struct {
+	ID                 *string    `json:"id,omitempty"`
+	PageID             *string    `json:"page_id,omitempty"`
+	Name               *string    `json:"name,omitempty"`
+	ExternalIdentifier *string    `json:"external_identifier,omitempty"`
+	PageAccessUserIDs  []string   `json:"page_access_user_ids,omitempty"`
+	ComponentIDs       []string   `json:"component_ids,omitempty"`
+	MetricIDs          []string   `json:"metric_ids,omitempty"`
+	CreatedAt          *Timestamp `json:"created_at,omitempty"`
+	UpdatedAt          *Timestamp `json:"updated_at,omitempty"`
+}
+
+func (g PageAccessGroup) String() string {
+	return Stringify(g)
+}
+
+// ListPageAccessGroups returns a list of page access groups for a given page id
+func (s *PageAccessGroupService) ListPageAccessGroups(ctx context.Context, pageID string, opts *ListOptions) (*[]PageAccessGroup, *Response, error) {
+	path, err := addOptions("v1/pages/"+pageID+"/page_access_groups", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.newRequest("GET", path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var groups []PageAccessGroup
+	resp, err := s.client.do(ctx, req, &groups)
+
+	return &groups, resp, err
+}
+
+// All returns an iterator over all page access groups for a given page id,
+// fetching further result pages as needed
+func (s *PageAccessGroupService) All(ctx context.Context, pageID string) iter.Seq2[PageAccessGroup, error] {
+	return allPages(ctx, func(ctx context.Context, opts *ListOptions) (*[]PageAccessGroup, *Response, error) {
+		return s.ListPageAccessGroups(ctx, pageID, opts)
+	})
+}
+
+// GetPageAccessGroup returns page access group information for a given page and group id
+func (s *PageAccessGroupService) GetPageAccessGroup(ctx context.Context, pageID string, groupID string) (*PageAccessGroup, *Response, error) {
+	path := "v1/pages/" + pageID + "/page_access_groups/" + groupID
+	req, err := s.client.newRequest("GET", path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var group PageAccessGroup
+	resp, err := s.client.do(ctx, req, &group)
+
+	return &group, resp, err
+}
+
+// CreatePageAccessGroupParams are the parameters that can be set using the create page access group API endpoint
+type CreatePageAccessGroupParams struct {
+	Name               string   `json:"name,omitempty"`
+	ExternalIdentifier string   `json:"external_identifier,omitempty"`
+	ComponentIDs       []string `json:"component_ids,omitempty"`
+	MetricIDs          []string `json:"metric_ids,omitempty"`
+	PageAccessUserIDs  []string `json:"page_access_user_ids,omitempty"`
+}
+
+// CreatePageAccessGroupRequestBody is the create page access group request body representation
+type CreatePageAccessGroupRequestBody struct {
+	PageAccessGroup CreatePageAccessGroupParams `json:"page_access_group"`
+}
+
+// CreatePageAccessGroup creates a page access group for a given page id
+func (s *PageAccessGroupService) CreatePageAccessGroup(ctx context.Context, pageID string, group CreatePageAccessGroupParams) (*PageAccessGroup, *Response, error) {
+	path := "v1/pages/" + pageID + "/page_access_groups"
+	payload := CreatePageAccessGroupRequestBody{PageAccessGroup: group}
+	req, err := s.client.newRequest("POST", path, payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var createdGroup PageAccessGroup
+	resp, err := s.client.do(ctx, req, &createdGroup)
+
+	return &createdGroup, resp, err
+}
+
+// UpdatePageAccessGroupParams are the parameters that can be changed using the update page access group API endpoint
+type UpdatePageAccessGroupParams struct {
+	Name               string   `json:"name,omitempty"`
+	ExternalIdentifier string   `json:"external_identifier,omitempty"`
+	ComponentIDs       []string `json:"component_ids,omitempty"`
+	MetricIDs          []string `json:"metric_ids,omitempty"`
+	PageAccessUserIDs  []string `json:"page_access_user_ids,omitempty"`
+}
+
+// UpdatePageAccessGroupRequestBody is the update page access group request body representation
+type UpdatePageAccessGroupRequestBody struct {
+	PageAccessGroup UpdatePageAccessGroupParams `json:"page_access_group"`
+}
+
+// UpdatePageAccessGroup updates a page access group for a given page and group id
+func (s *PageAccessGroupService) UpdatePageAccessGroup(ctx context.Context, pageID string, groupID string, group UpdatePageAccessGroupParams) (*PageAccessGroup, *Response, error) {
+	path := "v1/pages/" + pageID + "/page_access_groups/" + groupID
+	payload := UpdatePageAccessGroupRequestBody{PageAccessGroup: group}
+	req, err := s.client.newRequest("PATCH", path, payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var updatedGroup PageAccessGroup
+	resp, err := s.client.do(ctx, req, &updatedGroup)
+
+	return &updatedGroup, resp, err
+}
+
+// DeletePageAccessGroup deletes a page access group for a given page and group id
+func (s *PageAccessGroupService) DeletePageAccessGroup(ctx context.Context, pageID string, groupID string) (*Response, error) {
+	path := "v1/pages/" + pageID + "/page_access_groups/" + groupID
+	req, err := s.client.newRequest("DELETE", path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.do(ctx, req, nil)
+}
+
+// ListComponents returns the components visible to the members of a page access group for a given page and group id
+func (s *PageAccessGroupService) ListComponents(ctx context.Context, pageID string, groupID string, opts *ListOptions) (*[]Component, *Response, error) {
+	path, err := addOptions("v1/pages/"+pageID+"/page_access_groups/"+groupID+"/components", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.newRequest("GET", path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var components []Component
+	resp, err := s.client.do(ctx, req, &components)
+
+	return &components, resp, err
+}
+
+// AddComponents makes components visible to the members of a page access group for a given page and group id
+func (s *PageAccessGroupService) AddComponents(ctx context.Context, pageID string, groupID string, componentIDs []string) (*PageAccessGroup, *Response, error) {
+	return s.updateComponents(ctx, "PATCH", pageID, groupID, componentIDs)
+}
+
+// ReplaceComponents replaces the components visible to the members of a page access group for a given page and group id
+func (s *PageAccessGroupService) ReplaceComponents(ctx context.Context, pageID string, groupID string, componentIDs []string) (*PageAccessGroup, *Response, error) {
+	return s.updateComponents(ctx, "PUT", pageID, groupID, componentIDs)
+}
+
+// RemoveComponents hides components from the members of a page access group for a given page and group id
+func (s *PageAccessGroupService) RemoveComponents(ctx context.Context, pageID string, groupID string, componentIDs []string) (*PageAccessGroup, *Response, error) {
+	return s.updateComponents(ctx, "DELETE", pageID, groupID, componentIDs)
+}
+
+// RemoveComponent hides a single component from the members of a page access group for a given page, group and component id
+func (s *PageAccessGroupService) RemoveComponent(ctx context.Context, pageID string, groupID string, componentID string) (*Response, error) {
+	path := "v1/pages/" + pageID + "/page_access_groups/" + groupID + "/components/" + componentID
+	req, err := s.client.newRequest("DELETE", path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.do(ctx, req, nil)
+}
+
+func (s *PageAccessGroupService) updateComponents(ctx context.Context, method string, pageID string, groupID string, componentIDs []string) (*PageAccessGroup, *Response, error) {
+	path := "v1/pages/" + pageID + "/page_access_groups/" + groupID + "/components"
+	payload := PageAccessComponentsRequestBody{ComponentIDs: componentIDs}
+	req, err := s.client.newRequest(method, path, payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var group PageAccessGroup
+	resp, err := s.client.do(ctx, req, &group)
+
+	return &group, resp, err
+}
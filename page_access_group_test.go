@@ -0,0 +1,202 @@
+package statuspage
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestPageAccessGroupService_ListPageAccessGroups(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v1/pages/1/page_access_groups", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[{"id":"g", "page_access_user_ids":["u"], "component_ids":["c"]}]`)
+	})
+
+	groups, _, err := client.PageAccessGroup.ListPageAccessGroups(context.Background(), "1", nil)
+	if err != nil {
+		t.Errorf("PageAccessGroupService.ListPageAccessGroups returned error: %v", err)
+	}
+
+	want := &[]PageAccessGroup{{ID: String("g"), PageAccessUserIDs: []string{"u"}, ComponentIDs: []string{"c"}}}
+	if !reflect.DeepEqual(groups, want) {
+		t.Errorf("PageAccessGroupService.ListPageAccessGroups returned %+v, want %+v", groups, want)
+	}
+}
+
+func TestPageAccessGroupService_GetPageAccessGroup(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v1/pages/1/page_access_groups/g", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id":"g", "name":"Acme", "external_identifier":"crm-acme"}`)
+	})
+
+	group, _, err := client.PageAccessGroup.GetPageAccessGroup(context.Background(), "1", "g")
+	if err != nil {
+		t.Errorf("PageAccessGroupService.GetPageAccessGroup returned error: %v", err)
+	}
+
+	want := &PageAccessGroup{ID: String("g"), Name: String("Acme"), ExternalIdentifier: String("crm-acme")}
+	if !reflect.DeepEqual(group, want) {
+		t.Errorf("PageAccessGroupService.GetPageAccessGroup returned %+v, want %+v", group, want)
+	}
+}
+
+func TestPageAccessGroupService_CreatePageAccessGroup(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	input := CreatePageAccessGroupParams{Name: "Acme", ExternalIdentifier: "crm-acme", ComponentIDs: []string{"c"}}
+
+	mux.HandleFunc("/v1/pages/1/page_access_groups", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+
+		v := &CreatePageAccessGroupRequestBody{}
+		json.NewDecoder(r.Body).Decode(v)
+		if !reflect.DeepEqual(v.PageAccessGroup, input) {
+			t.Errorf("Request body = %+v, want %+v", v.PageAccessGroup, input)
+		}
+
+		fmt.Fprint(w, `{"id":"g"}`)
+	})
+
+	group, _, err := client.PageAccessGroup.CreatePageAccessGroup(context.Background(), "1", input)
+	if err != nil {
+		t.Errorf("PageAccessGroupService.CreatePageAccessGroup returned error: %v", err)
+	}
+
+	want := &PageAccessGroup{ID: String("g")}
+	if !reflect.DeepEqual(group, want) {
+		t.Errorf("PageAccessGroupService.CreatePageAccessGroup returned %+v, want %+v", group, want)
+	}
+}
+
+func TestPageAccessGroupService_UpdatePageAccessGroup(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	input := UpdatePageAccessGroupParams{PageAccessUserIDs: []string{"u", "v"}}
+
+	mux.HandleFunc("/v1/pages/1/page_access_groups/g", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+
+		v := &UpdatePageAccessGroupRequestBody{}
+		json.NewDecoder(r.Body).Decode(v)
+		if !reflect.DeepEqual(v.PageAccessGroup, input) {
+			t.Errorf("Request body = %+v, want %+v", v.PageAccessGroup, input)
+		}
+
+		fmt.Fprint(w, `{"id":"g", "page_access_user_ids":["u", "v"]}`)
+	})
+
+	group, _, err := client.PageAccessGroup.UpdatePageAccessGroup(context.Background(), "1", "g", input)
+	if err != nil {
+		t.Errorf("PageAccessGroupService.UpdatePageAccessGroup returned error: %v", err)
+	}
+
+	want := &PageAccessGroup{ID: String("g"), PageAccessUserIDs: []string{"u", "v"}}
+	if !reflect.DeepEqual(group, want) {
+		t.Errorf("PageAccessGroupService.UpdatePageAccessGroup returned %+v, want %+v", group, want)
+	}
+}
+
+func TestPageAccessGroupService_DeletePageAccessGroup(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v1/pages/1/page_access_groups/g", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := client.PageAccessGroup.DeletePageAccessGroup(context.Background(), "1", "g"); err != nil {
+		t.Errorf("PageAccessGroupService.DeletePageAccessGroup returned error: %v", err)
+	}
+}
+
+func TestPageAccessGroupService_ListComponents(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v1/pages/1/page_access_groups/g/components", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[{"id":"c"}]`)
+	})
+
+	components, _, err := client.PageAccessGroup.ListComponents(context.Background(), "1", "g", nil)
+	if err != nil {
+		t.Errorf("PageAccessGroupService.ListComponents returned error: %v", err)
+	}
+
+	want := &[]Component{{ID: String("c")}}
+	if !reflect.DeepEqual(components, want) {
+		t.Errorf("PageAccessGroupService.ListComponents returned %+v, want %+v", components, want)
+	}
+}
+
+func TestPageAccessGroupService_components(t *testing.T) {
+	tests := []struct {
+		method string
+		call   func(PageAccessGroupAPI) (*PageAccessGroup, *Response, error)
+	}{
+		{"PATCH", func(s PageAccessGroupAPI) (*PageAccessGroup, *Response, error) {
+			return s.AddComponents(context.Background(), "1", "g", []string{"c"})
+		}},
+		{"PUT", func(s PageAccessGroupAPI) (*PageAccessGroup, *Response, error) {
+			return s.ReplaceComponents(context.Background(), "1", "g", []string{"c"})
+		}},
+		{"DELETE", func(s PageAccessGroupAPI) (*PageAccessGroup, *Response, error) {
+			return s.RemoveComponents(context.Background(), "1", "g", []string{"c"})
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.method, func(t *testing.T) {
+			client, mux, _, teardown := setup()
+			defer teardown()
+
+			mux.HandleFunc("/v1/pages/1/page_access_groups/g/components", func(w http.ResponseWriter, r *http.Request) {
+				testMethod(t, r, tt.method)
+
+				v := &PageAccessComponentsRequestBody{}
+				json.NewDecoder(r.Body).Decode(v)
+				if want := []string{"c"}; !reflect.DeepEqual(v.ComponentIDs, want) {
+					t.Errorf("Request body = %+v, want %+v", v.ComponentIDs, want)
+				}
+
+				fmt.Fprint(w, `{"id":"g"}`)
+			})
+
+			group, _, err := tt.call(client.PageAccessGroup)
+			if err != nil {
+				t.Errorf("PageAccessGroupService returned error: %v", err)
+			}
+
+			want := &PageAccessGroup{ID: String("g")}
+			if !reflect.DeepEqual(group, want) {
+				t.Errorf("PageAccessGroupService returned %+v, want %+v", group, want)
+			}
+		})
+	}
+}
+
+func TestPageAccessGroupService_RemoveComponent(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v1/pages/1/page_access_groups/g/components/c", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := client.PageAccessGroup.RemoveComponent(context.Background(), "1", "g", "c"); err != nil {
+		t.Errorf("PageAccessGroupService.RemoveComponent returned error: %v", err)
+	}
+}
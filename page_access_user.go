@@ -0,0 +1,280 @@
+package statuspage
+
+import (
+	"context"
+	"iter"
+)
+
+// PageAccessUserService handles communication with the page access user
+// related methods of the Statuspage API. Page access users are the viewers of
+// audience-specific pages.
+//
+// Statuspage API docs: https://developer.statuspage.io/#tag/page-access-users
+type PageAccessUserService service
+
+// PageAccessUserAPI is the interface implemented by PageAccessUserService.
+type PageAccessUserAPI interface {
+	ListPageAccessUsers(ctx context.Context, pageID string, opts *PageAccessUserListOptions) (*[]PageAccessUser, *Response, error)
+	All(ctx context.Context, pageID string, opts PageAccessUserListOptions) iter.Seq2[PageAccessUser, error]
+	GetPageAccessUser(ctx context.Context, pageID string, userID string) (*PageAccessUser, *Response, error)
+	CreatePageAccessUser(ctx context.Context, pageID string, user CreatePageAccessUserParams) (*PageAccessUser, *Response, error)
+	UpdatePageAccessUser(ctx context.Context, pageID string, userID string, user UpdatePageAccessUserParams) (*PageAccessUser, *Response, error)
+	DeletePageAccessUser(ctx context.Context, pageID string, userID string) (*Response, error)
+	ListComponents(ctx context.Context, pageID string, userID string, opts *ListOptions) (*[]Component, *Response, error)
+	AddComponents(ctx context.Context, pageID string, userID string, componentIDs []string) (*PageAccessUser, *Response, error)
+	ReplaceComponents(ctx context.Context, pageID string, userID string, componentIDs []string) (*PageAccessUser, *Response, error)
+	RemoveComponents(ctx context.Context, pageID string, userID string, componentIDs []string) (*PageAccessUser, *Response, error)
+	RemoveComponent(ctx context.Context, pageID string, userID string, componentID string) (*Response, error)
+	ListMetrics(ctx context.Context, pageID string, userID string, opts *ListOptions) (*[]Metric, *Response, error)
+	AddMetrics(ctx context.Context, pageID string, userID string, metricIDs []string) (*PageAccessUser, *Response, error)
+	ReplaceMetrics(ctx context.Context, pageID string, userID string, metricIDs []string) (*PageAccessUser, *Response, error)
+	RemoveMetrics(ctx context.Context, pageID string, userID string, metricIDs []string) (*PageAccessUser, *Response, error)
+	RemoveMetric(ctx context.Context, pageID string, userID string, metricID string) (*Response, error)
+}
+
+var _ PageAccessUserAPI = (*PageAccessUserService)(nil)
+
+// PageAccessUser is the Statuspage API page access user representation
+type PageAccessUser struct {
+	ID                 *string    `json:"id,omitempty"`
+	PageID             *string    `json:"page_id,omitempty"`
+	Email              *string    `json:"email,omitempty"`
+	ExternalLogin      *string    `json:"external_login,omitempty"`
+	PageAccessGroupID  *string    `json:"page_access_group_id,omitempty"`
+	PageAccessGroupIDs []string   `json:"page_access_group_ids,omitempty"`
+	CreatedAt          *Timestamp `json:"created_at,omitempty"`
+	UpdatedAt          *Timestamp `json:"updated_at,omitempty"`
+}
+
+func (u PageAccessUser) String() string {
+	return Stringify(u)
+}
+
+// PageAccessUserListOptions specifies the optional parameters to the
+// PageAccessUserService.ListPageAccessUsers method.
+type PageAccessUserListOptions struct {
+	Email string `url:"email,omitempty"`
+
+	ListOptions
+}
+
+// ListPageAccessUsers returns a list of page access users for a given page id
+func (s *PageAccessUserService) ListPageAccessUsers(ctx context.Context, pageID string, opts *PageAccessUserListOptions) (*[]PageAccessUser, *Response, error) {
+	path, err := addOptions("v1/pages/"+pageID+"/page_access_users", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.newRequest("GET", path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var users []PageAccessUser
+	resp, err := s.client.do(ctx, req, &users)
+
+	return &users, resp, err
+}
+
+// All returns an iterator over all page access users for a given page id
+// matching opts, fetching further result pages as needed
+func (s *PageAccessUserService) All(ctx context.Context, pageID string, opts PageAccessUserListOptions) iter.Seq2[PageAccessUser, error] {
+	return allPages(ctx, func(ctx context.Context, listOpts *ListOptions) (*[]PageAccessUser, *Response, error) {
+		opts.ListOptions = *listOpts
+		return s.ListPageAccessUsers(ctx, pageID, &opts)
+	})
+}
+
+// GetPageAccessUser returns page access user information for a given page and user id
+func (s *PageAccessUserService) GetPageAccessUser(ctx context.Context, pageID string, userID string) (*PageAccessUser, *Response, error) {
+	path := "v1/pages/" + pageID + "/page_access_users/" + userID
+	req, err := s.client.newRequest("GET", path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var user PageAccessUser
+	resp, err := s.client.do(ctx, req, &user)
+
+	return &user, resp, err
+}
+
+// CreatePageAccessUserParams are the parameters that can be set using the create page access user API endpoint
+type CreatePageAccessUserParams struct {
+	Email                 string   `json:"email,omitempty"`
+	ExternalLogin         string   `json:"external_login,omitempty"`
+	PageAccessGroupIDs    []string `json:"page_access_group_ids,omitempty"`
+	SubscribeToComponents *bool    `json:"subscribe_to_components,omitempty"`
+}
+
+// CreatePageAccessUserRequestBody is the create page access user request body representation
+type CreatePageAccessUserRequestBody struct {
+	PageAccessUser CreatePageAccessUserParams `json:"page_access_user"`
+}
+
+// CreatePageAccessUser creates a page access user for a given page id
+func (s *PageAccessUserService) CreatePageAccessUser(ctx context.Context, pageID string, user CreatePageAccessUserParams) (*PageAccessUser, *Response, error) {
+	path := "v1/pages/" + pageID + "/page_access_users"
+	payload := CreatePageAccessUserRequestBody{PageAccessUser: user}
+	req, err := s.client.newRequest("POST", path, payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var createdUser PageAccessUser
+	resp, err := s.client.do(ctx, req, &createdUser)
+
+	return &createdUser, resp, err
+}
+
+// UpdatePageAccessUserParams are the parameters that can be changed using the update page access user API endpoint
+type UpdatePageAccessUserParams struct {
+	ExternalLogin      string   `json:"external_login,omitempty"`
+	PageAccessGroupIDs []string `json:"page_access_group_ids,omitempty"`
+}
+
+// UpdatePageAccessUserRequestBody is the update page access user request body representation
+type UpdatePageAccessUserRequestBody struct {
+	PageAccessUser UpdatePageAccessUserParams `json:"page_access_user"`
+}
+
+// UpdatePageAccessUser updates a page access user for a given page and user id
+func (s *PageAccessUserService) UpdatePageAccessUser(ctx context.Context, pageID string, userID string, user UpdatePageAccessUserParams) (*PageAccessUser, *Response, error) {
+	path := "v1/pages/" + pageID + "/page_access_users/" + userID
+	payload := UpdatePageAccessUserRequestBody{PageAccessUser: user}
+	req, err := s.client.newRequest("PATCH", path, payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var updatedUser PageAccessUser
+	resp, err := s.client.do(ctx, req, &updatedUser)
+
+	return &updatedUser, resp, err
+}
+
+// DeletePageAccessUser deletes a page access user for a given page and user id
+func (s *PageAccessUserService) DeletePageAccessUser(ctx context.Context, pageID string, userID string) (*Response, error) {
+	path := "v1/pages/" + pageID + "/page_access_users/" + userID
+	req, err := s.client.newRequest("DELETE", path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.do(ctx, req, nil)
+}
+
+// PageAccessComponentsRequestBody is the request body representation used to
+// add, replace or remove the components of a page access user or group
+type PageAccessComponentsRequestBody struct {
+	ComponentIDs []string `json:"component_ids"`
+}
+
+// PageAccessMetricsRequestBody is the request body representation used to
+// add, replace or remove the metrics of a page access user
+type PageAccessMetricsRequestBody struct {
+	MetricIDs []string `json:"metric_ids"`
+}
+
+// ListComponents returns the components visible to a page access user for a given page and user id
+func (s *PageAccessUserService) ListComponents(ctx context.Context, pageID string, userID string, opts *ListOptions) (*[]Component, *Response, error) {
+	path, err := addOptions("v1/pages/"+pageID+"/page_access_users/"+userID+"/components", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.newRequest("GET", path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var components []Component
+	resp, err := s.client.do(ctx, req, &components)
+
+	return &components, resp, err
+}
+
+// AddComponents makes components visible to a page access user for a given page and user id
+func (s *PageAccessUserService) AddComponents(ctx context.Context, pageID string, userID string, componentIDs []string) (*PageAccessUser, *Response, error) {
+	return s.update(ctx, "PATCH", pageID, userID, "components", PageAccessComponentsRequestBody{ComponentIDs: componentIDs})
+}
+
+// ReplaceComponents replaces the components visible to a page access user for a given page and user id
+func (s *PageAccessUserService) ReplaceComponents(ctx context.Context, pageID string, userID string, componentIDs []string) (*PageAccessUser, *Response, error) {
+	return s.update(ctx, "PUT", pageID, userID, "components", PageAccessComponentsRequestBody{ComponentIDs: componentIDs})
+}
+
+// RemoveComponents hides components from a page access user for a given page and user id
+func (s *PageAccessUserService) RemoveComponents(ctx context.Context, pageID string, userID string, componentIDs []string) (*PageAccessUser, *Response, error) {
+	return s.update(ctx, "DELETE", pageID, userID, "components", PageAccessComponentsRequestBody{ComponentIDs: componentIDs})
+}
+
+// RemoveComponent hides a single component from a page access user for a given page, user and component id
+func (s *PageAccessUserService) RemoveComponent(ctx context.Context, pageID string, userID string, componentID string) (*Response, error) {
+	path := "v1/pages/" + pageID + "/page_access_users/" + userID + "/components/" + componentID
+	req, err := s.client.newRequest("DELETE", path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.do(ctx, req, nil)
+}
+
+// ListMetrics returns the metrics visible to a page access user for a given page and user id
+func (s *PageAccessUserService) ListMetrics(ctx context.Context, pageID string, userID string, opts *ListOptions) (*[]Metric, *Response, error) {
+	path, err := addOptions("v1/pages/"+pageID+"/page_access_users/"+userID+"/metrics", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.newRequest("GET", path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var metrics []Metric
+	resp, err := s.client.do(ctx, req, &metrics)
+
+	return &metrics, resp, err
+}
+
+// AddMetrics makes metrics visible to a page access user for a given page and user id
+func (s *PageAccessUserService) AddMetrics(ctx context.Context, pageID string, userID string, metricIDs []string) (*PageAccessUser, *Response, error) {
+	return s.update(ctx, "PATCH", pageID, userID, "metrics", PageAccessMetricsRequestBody{MetricIDs: metricIDs})
+}
+
+// ReplaceMetrics replaces the metrics visible to a page access user for a given page and user id
+func (s *PageAccessUserService) ReplaceMetrics(ctx context.Context, pageID string, userID string, metricIDs []string) (*PageAccessUser, *Response, error) {
+	return s.update(ctx, "PUT", pageID, userID, "metrics", PageAccessMetricsRequestBody{MetricIDs: metricIDs})
+}
+
+// RemoveMetrics hides metrics from a page access user for a given page and user id
+func (s *PageAccessUserService) RemoveMetrics(ctx context.Context, pageID string, userID string, metricIDs []string) (*PageAccessUser, *Response, error) {
+	return s.update(ctx, "DELETE", pageID, userID, "metrics", PageAccessMetricsRequestBody{MetricIDs: metricIDs})
+}
+
+// RemoveMetric hides a single metric from a page access user for a given page, user and metric id
+func (s *PageAccessUserService) RemoveMetric(ctx context.Context, pageID string, userID string, metricID string) (*Response, error) {
+	path := "v1/pages/" + pageID + "/page_access_users/" + userID + "/metrics/" + metricID
+	req, err := s.client.newRequest("DELETE", path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.do(ctx, req, nil)
+}
+
+// update sends payload to the components or metrics endpoint of a page
+// access user using the given method.
+func (s *PageAccessUserService) update(ctx context.Context, method string, pageID string, userID string, resource string, payload interface{}) (*PageAccessUser, *Response, error) {
+	path := "v1/pages/" + pageID + "/page_access_users/" + userID + "/" + resource
+	req, err := s.client.newRequest(method, path, payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var user PageAccessUser
+	resp, err := s.client.do(ctx, req, &user)
+
+	return &user, resp, err
+}
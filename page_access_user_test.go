@@ -0,0 +1,290 @@
+package statuspage
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestPageAccessUserService_ListPageAccessUsers(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v1/pages/1/page_access_users", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if got := r.URL.Query().Get("email"); got != "a@example.com" {
+			t.Errorf("email = %q, want %q", got, "a@example.com")
+		}
+		fmt.Fprint(w, `[{"id":"u", "email":"a@example.com", "page_access_group_ids":["g"]}]`)
+	})
+
+	opts := &PageAccessUserListOptions{Email: "a@example.com"}
+	users, _, err := client.PageAccessUser.ListPageAccessUsers(context.Background(), "1", opts)
+	if err != nil {
+		t.Errorf("PageAccessUserService.ListPageAccessUsers returned error: %v", err)
+	}
+
+	want := &[]PageAccessUser{{ID: String("u"), Email: String("a@example.com"), PageAccessGroupIDs: []string{"g"}}}
+	if !reflect.DeepEqual(users, want) {
+		t.Errorf("PageAccessUserService.ListPageAccessUsers returned %+v, want %+v", users, want)
+	}
+}
+
+func TestPageAccessUserService_GetPageAccessUser(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v1/pages/1/page_access_users/u", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id":"u", "external_login":"crm-1"}`)
+	})
+
+	user, _, err := client.PageAccessUser.GetPageAccessUser(context.Background(), "1", "u")
+	if err != nil {
+		t.Errorf("PageAccessUserService.GetPageAccessUser returned error: %v", err)
+	}
+
+	want := &PageAccessUser{ID: String("u"), ExternalLogin: String("crm-1")}
+	if !reflect.DeepEqual(user, want) {
+		t.Errorf("PageAccessUserService.GetPageAccessUser returned %+v, want %+v", user, want)
+	}
+}
+
+func TestPageAccessUserService_CreatePageAccessUser(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	input := CreatePageAccessUserParams{
+		Email:                 "a@example.com",
+		PageAccessGroupIDs:    []string{"g"},
+		SubscribeToComponents: Bool(false),
+	}
+
+	mux.HandleFunc("/v1/pages/1/page_access_users", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+
+		v := &CreatePageAccessUserRequestBody{}
+		json.NewDecoder(r.Body).Decode(v)
+		if !reflect.DeepEqual(v.PageAccessUser, input) {
+			t.Errorf("Request body = %+v, want %+v", v.PageAccessUser, input)
+		}
+
+		fmt.Fprint(w, `{"id":"u"}`)
+	})
+
+	user, _, err := client.PageAccessUser.CreatePageAccessUser(context.Background(), "1", input)
+	if err != nil {
+		t.Errorf("PageAccessUserService.CreatePageAccessUser returned error: %v", err)
+	}
+
+	want := &PageAccessUser{ID: String("u")}
+	if !reflect.DeepEqual(user, want) {
+		t.Errorf("PageAccessUserService.CreatePageAccessUser returned %+v, want %+v", user, want)
+	}
+}
+
+func TestPageAccessUserService_UpdatePageAccessUser(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	input := UpdatePageAccessUserParams{ExternalLogin: "crm-2"}
+
+	mux.HandleFunc("/v1/pages/1/page_access_users/u", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+
+		v := &UpdatePageAccessUserRequestBody{}
+		json.NewDecoder(r.Body).Decode(v)
+		if !reflect.DeepEqual(v.PageAccessUser, input) {
+			t.Errorf("Request body = %+v, want %+v", v.PageAccessUser, input)
+		}
+
+		fmt.Fprint(w, `{"id":"u", "external_login":"crm-2"}`)
+	})
+
+	user, _, err := client.PageAccessUser.UpdatePageAccessUser(context.Background(), "1", "u", input)
+	if err != nil {
+		t.Errorf("PageAccessUserService.UpdatePageAccessUser returned error: %v", err)
+	}
+
+	want := &PageAccessUser{ID: String("u"), ExternalLogin: String("crm-2")}
+	if !reflect.DeepEqual(user, want) {
+		t.Errorf("PageAccessUserService.UpdatePageAccessUser returned %+v, want %+v", user, want)
+	}
+}
+
+func TestPageAccessUserService_DeletePageAccessUser(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v1/pages/1/page_access_users/u", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := client.PageAccessUser.DeletePageAccessUser(context.Background(), "1", "u"); err != nil {
+		t.Errorf("PageAccessUserService.DeletePageAccessUser returned error: %v", err)
+	}
+}
+
+func TestPageAccessUserService_ListComponents(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v1/pages/1/page_access_users/u/components", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[{"id":"c"}]`)
+	})
+
+	components, _, err := client.PageAccessUser.ListComponents(context.Background(), "1", "u", nil)
+	if err != nil {
+		t.Errorf("PageAccessUserService.ListComponents returned error: %v", err)
+	}
+
+	want := &[]Component{{ID: String("c")}}
+	if !reflect.DeepEqual(components, want) {
+		t.Errorf("PageAccessUserService.ListComponents returned %+v, want %+v", components, want)
+	}
+}
+
+func TestPageAccessUserService_components(t *testing.T) {
+	tests := []struct {
+		method string
+		call   func(PageAccessUserAPI) (*PageAccessUser, *Response, error)
+	}{
+		{"PATCH", func(s PageAccessUserAPI) (*PageAccessUser, *Response, error) {
+			return s.AddComponents(context.Background(), "1", "u", []string{"c"})
+		}},
+		{"PUT", func(s PageAccessUserAPI) (*PageAccessUser, *Response, error) {
+			return s.ReplaceComponents(context.Background(), "1", "u", []string{"c"})
+		}},
+		{"DELETE", func(s PageAccessUserAPI) (*PageAccessUser, *Response, error) {
+			return s.RemoveComponents(context.Background(), "1", "u", []string{"c"})
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.method, func(t *testing.T) {
+			client, mux, _, teardown := setup()
+			defer teardown()
+
+			mux.HandleFunc("/v1/pages/1/page_access_users/u/components", func(w http.ResponseWriter, r *http.Request) {
+				testMethod(t, r, tt.method)
+
+				v := &PageAccessComponentsRequestBody{}
+				json.NewDecoder(r.Body).Decode(v)
+				if want := []string{"c"}; !reflect.DeepEqual(v.ComponentIDs, want) {
+					t.Errorf("Request body = %+v, want %+v", v.ComponentIDs, want)
+				}
+
+				fmt.Fprint(w, `{"id":"u"}`)
+			})
+
+			user, _, err := tt.call(client.PageAccessUser)
+			if err != nil {
+				t.Errorf("PageAccessUserService returned error: %v", err)
+			}
+
+			want := &PageAccessUser{ID: String("u")}
+			if !reflect.DeepEqual(user, want) {
+				t.Errorf("PageAccessUserService returned %+v, want %+v", user, want)
+			}
+		})
+	}
+}
+
+func TestPageAccessUserService_RemoveComponent(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v1/pages/1/page_access_users/u/components/c", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := client.PageAccessUser.RemoveComponent(context.Background(), "1", "u", "c"); err != nil {
+		t.Errorf("PageAccessUserService.RemoveComponent returned error: %v", err)
+	}
+}
+
+func TestPageAccessUserService_ListMetrics(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v1/pages/1/page_access_users/u/metrics", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[{"id":"m"}]`)
+	})
+
+	metrics, _, err := client.PageAccessUser.ListMetrics(context.Background(), "1", "u", nil)
+	if err != nil {
+		t.Errorf("PageAccessUserService.ListMetrics returned error: %v", err)
+	}
+
+	want := &[]Metric{{ID: String("m")}}
+	if !reflect.DeepEqual(metrics, want) {
+		t.Errorf("PageAccessUserService.ListMetrics returned %+v, want %+v", metrics, want)
+	}
+}
+
+func TestPageAccessUserService_metrics(t *testing.T) {
+	tests := []struct {
+		method string
+		call   func(PageAccessUserAPI) (*PageAccessUser, *Response, error)
+	}{
+		{"PATCH", func(s PageAccessUserAPI) (*PageAccessUser, *Response, error) {
+			return s.AddMetrics(context.Background(), "1", "u", []string{"m"})
+		}},
+		{"PUT", func(s PageAccessUserAPI) (*PageAccessUser, *Response, error) {
+			return s.ReplaceMetrics(context.Background(), "1", "u", []string{"m"})
+		}},
+		{"DELETE", func(s PageAccessUserAPI) (*PageAccessUser, *Response, error) {
+			return s.RemoveMetrics(context.Background(), "1", "u", []string{"m"})
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.method, func(t *testing.T) {
+			client, mux, _, teardown := setup()
+			defer teardown()
+
+			mux.HandleFunc("/v1/pages/1/page_access_users/u/metrics", func(w http.ResponseWriter, r *http.Request) {
+				testMethod(t, r, tt.method)
+
+				v := &PageAccessMetricsRequestBody{}
+				json.NewDecoder(r.Body).Decode(v)
+				if want := []string{"m"}; !reflect.DeepEqual(v.MetricIDs, want) {
+					t.Errorf("Request body = %+v, want %+v", v.MetricIDs, want)
+				}
+
+				fmt.Fprint(w, `{"id":"u"}`)
+			})
+
+			user, _, err := tt.call(client.PageAccessUser)
+			if err != nil {
+				t.Errorf("PageAccessUserService returned error: %v", err)
+			}
+
+			want := &PageAccessUser{ID: String("u")}
+			if !reflect.DeepEqual(user, want) {
+				t.Errorf("PageAccessUserService returned %+v, want %+v", user, want)
+			}
+		})
+	}
+}
+
+func TestPageAccessUserService_RemoveMetric(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v1/pages/1/page_access_users/u/metrics/m", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := client.PageAccessUser.RemoveMetric(context.Background(), "1", "u", "m"); err != nil {
+		t.Errorf("PageAccessUserService.RemoveMetric returned error: %v", err)
+	}
+}
@@ -158,7 +158,7 @@ func TestPageService_GetPage(t *testing.T) {
 		fmt.Fprint(w, `{"id":"1"}`)
 	})
 
-	page, err := client.Page.GetPage(context.Background(), "1")
+	page, _, err := client.Page.GetPage(context.Background(), "1")
 	if err != nil {
 		t.Errorf("PageService.GetPage returned error: %v", err)
 	}
@@ -178,7 +178,7 @@ func TestPageService_ListPages(t *testing.T) {
 		fmt.Fprint(w, `[{"id":"1"}, {"id":"2"}]`)
 	})
 
-	page, err := client.Page.ListPages(context.Background())
+	page, _, err := client.Page.ListPages(context.Background(), nil)
 	if err != nil {
 		t.Errorf("PageService.ListPages returned error: %v", err)
 	}
@@ -214,7 +214,7 @@ func TestPageService_UpdatePage(t *testing.T) {
 		fmt.Fprint(w, `{"id":"1"}`)
 	})
 
-	page, err := client.Page.UpdatePage(context.Background(), "1", input)
+	page, _, err := client.Page.UpdatePage(context.Background(), "1", input)
 	if err != nil {
 		t.Errorf("PageService.UpdatePage returned error: %v", err)
 	}
@@ -0,0 +1,57 @@
+package pagesync
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"maps"
+
+	"github.com/nagelflorian/statuspage-go"
+)
+
+// ApplyOptions specifies optional parameters of Plan.Apply.
+type ApplyOptions struct {
+	// DryRun reports the changes without making them.
+	DryRun bool
+
+	// Progress, if not nil, receives a line for every change made.
+	Progress io.Writer
+}
+
+// Apply makes the changes of the plan in order. It stops at the first
+// failing change and returns its error; the changes before it remain made.
+// Only changes returned by NewPlan can be applied; Apply returns an error
+// without making any change if the plan holds others.
+func (p *Plan) Apply(ctx context.Context, client *statuspage.Client, opts *ApplyOptions) error {
+	if opts == nil {
+		opts = &ApplyOptions{}
+	}
+	for _, c := range p.Changes {
+		if c.apply == nil {
+			return fmt.Errorf("pagesync: change %s %s %q was not created by NewPlan", c.Action, c.Resource, c.Name)
+		}
+	}
+	s := &state{
+		pageID:     p.PageID,
+		components: maps.Clone(p.components),
+	}
+	if s.components == nil {
+		s.components = map[string]string{}
+	}
+
+	for _, c := range p.Changes {
+		if !opts.DryRun {
+			if err := c.apply(ctx, client, s); err != nil {
+				return fmt.Errorf("pagesync: %s %s %q: %w", c.Action, c.Resource, c.Name, err)
+			}
+		}
+		if opts.Progress != nil {
+			verb := map[Action]string{Create: "created", Update: "updated", Delete: "deleted"}[c.Action]
+			if opts.DryRun {
+				verb = "would " + string(c.Action)
+			}
+			fmt.Fprintf(opts.Progress, "%s %s %q\n", verb, c.Resource, c.Name)
+		}
+	}
+	return nil
+}
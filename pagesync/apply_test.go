@@ -0,0 +1,141 @@
+package pagesync
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/nagelflorian/statuspage-go"
+)
+
+var applyConfig = &Config{
+	Page: statuspage.UpdatePageParams{TimeZone: "UTC"},
+	ComponentGroups: []ComponentGroup{
+		{Name: "Backend"},
+		{Name: "Frontend", Description: "Browsers"},
+	},
+	Components: []Component{
+		{Name: "API", Group: "Backend"},
+		{Name: "Database", Group: "Backend", Description: "Postgres"},
+		{Name: "Website", Group: "Frontend", Showcase: Bool(true)},
+	},
+}
+
+func TestPlan_Apply(t *testing.T) {
+	s := newServices(examplePage())
+	client := s.Client()
+	ctx := context.Background()
+
+	plan, err := NewPlan(ctx, client, "p1", applyConfig)
+	if err != nil {
+		t.Fatalf("NewPlan returned error: %v", err)
+	}
+	var progress bytes.Buffer
+	if err := plan.Apply(ctx, client, &ApplyOptions{Progress: &progress}); err != nil {
+		t.Fatalf("Plan.Apply returned error: %v", err)
+	}
+
+	if got, want := s.Page.CallsTo("UpdatePage")[0].Args[1], (statuspage.UpdatePageParams{TimeZone: "UTC"}); !reflect.DeepEqual(got, want) {
+		t.Errorf("Plan.Apply updated the page with %+v, want %+v", got, want)
+	}
+	if got, want := s.Component.CallsTo("CreateComponent")[0].Args[1], (statuspage.CreateComponentParams{Name: "Website", Showcase: true}); !reflect.DeepEqual(got, want) {
+		t.Errorf("Plan.Apply created the component %+v, want %+v", got, want)
+	}
+	wantGroup := statuspage.CreateComponentGroupParams{Name: "Frontend", Description: "Browsers", Components: []string{"new-Website"}}
+	if got := s.ComponentGroup.CallsTo("CreateComponentGroup")[0].Args[1]; !reflect.DeepEqual(got, wantGroup) {
+		t.Errorf("Plan.Apply created the component group %+v, want %+v", got, wantGroup)
+	}
+	if got, want := s.Component.CallsTo("UpdateComponent")[0].Args[1:], []interface{}{"c2", statuspage.UpdateComponentParams{Description: "Postgres"}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Plan.Apply updated the component with %+v, want %+v", got, want)
+	}
+	if got, want := s.Component.CallsTo("DeleteComponent")[0].Args[1], "c3"; got != want {
+		t.Errorf("Plan.Apply deleted the component %v, want %v", got, want)
+	}
+	if got, want := s.ComponentGroup.CallsTo("DeleteComponentGroup")[0].Args[1], "g2"; got != want {
+		t.Errorf("Plan.Apply deleted the component group %v, want %v", got, want)
+	}
+
+	want := `updated page "Example"
+created component "Website"
+created component_group "Frontend"
+updated component "Database"
+deleted component "Legacy"
+deleted component_group "Old"
+`
+	if got := progress.String(); got != want {
+		t.Errorf("Plan.Apply reported %q, want %q", got, want)
+	}
+}
+
+func TestPlan_Apply_dryRun(t *testing.T) {
+	s := newServices(examplePage())
+	client := s.Client()
+	ctx := context.Background()
+
+	plan, err := NewPlan(ctx, client, "p1", applyConfig)
+	if err != nil {
+		t.Fatalf("NewPlan returned error: %v", err)
+	}
+	s.Page.Reset()
+	s.Component.Reset()
+	s.ComponentGroup.Reset()
+
+	var progress bytes.Buffer
+	if err := plan.Apply(ctx, client, &ApplyOptions{DryRun: true, Progress: &progress}); err != nil {
+		t.Fatalf("Plan.Apply returned error: %v", err)
+	}
+	if n := len(s.Page.Calls()) + len(s.Component.Calls()) + len(s.ComponentGroup.Calls()); n != 0 {
+		t.Errorf("Plan.Apply made %d calls in a dry run, want 0", n)
+	}
+	if !strings.HasPrefix(progress.String(), "would update page \"Example\"\nwould create component \"Website\"\n") {
+		t.Errorf("Plan.Apply reported %q", progress.String())
+	}
+}
+
+func TestPlan_Apply_error(t *testing.T) {
+	s := newServices(examplePage())
+	client := s.Client()
+	ctx := context.Background()
+	failure := errors.New("boom")
+	s.Component.CreateComponentFunc = func(ctx context.Context, pageID string, params statuspage.CreateComponentParams) (*statuspage.Component, *statuspage.Response, error) {
+		return nil, nil, failure
+	}
+
+	plan, err := NewPlan(ctx, client, "p1", applyConfig)
+	if err != nil {
+		t.Fatalf("NewPlan returned error: %v", err)
+	}
+	err = plan.Apply(ctx, client, nil)
+	if !errors.Is(err, failure) || !strings.Contains(err.Error(), `create component "Website"`) {
+		t.Errorf("Plan.Apply returned error %v, want wrapped %v", err, failure)
+	}
+	if calls := s.ComponentGroup.CallsTo("CreateComponentGroup"); len(calls) != 0 {
+		t.Errorf("Plan.Apply continued after the error and made %+v", calls)
+	}
+}
+
+func TestPlan_Apply_foreignChange(t *testing.T) {
+	s := newServices(examplePage())
+	client := s.Client()
+	ctx := context.Background()
+
+	plan, err := NewPlan(ctx, client, "p1", applyConfig)
+	if err != nil {
+		t.Fatalf("NewPlan returned error: %v", err)
+	}
+	plan.Changes = append(plan.Changes, Change{Action: Delete, Resource: ResourceComponent, Name: "API", ID: "c1"})
+	s.Page.Reset()
+	s.Component.Reset()
+	s.ComponentGroup.Reset()
+
+	err = plan.Apply(ctx, client, nil)
+	if err == nil || !strings.Contains(err.Error(), "not created by NewPlan") {
+		t.Errorf("Plan.Apply returned error %v, want error about a change not created by NewPlan", err)
+	}
+	if n := len(s.Page.Calls()) + len(s.Component.Calls()) + len(s.ComponentGroup.Calls()); n != 0 {
+		t.Errorf("Plan.Apply made %d calls, want 0", n)
+	}
+}
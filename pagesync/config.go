@@ -0,0 +1,116 @@
+// Package pagesync synchronizes a status page with a desired state kept in
+// version control.
+//
+// A Config describes the page settings, component groups and components a
+// page should have. NewPlan compares it with the page and returns the
+// changes needed to reach it, which Plan.String renders for review and
+// Plan.Apply makes:
+//
+//	config, err := pagesync.Parse(data)
+//	plan, err := pagesync.NewPlan(ctx, client, pageID, config)
+//	fmt.Print(plan)
+//	err = plan.Apply(ctx, client, nil)
+//
+// Components and component groups are matched by name. Components and
+// groups of the page that the Config does not list are deleted. Settings
+// left empty in the Config are left as they are.
+package pagesync
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/nagelflorian/statuspage-go"
+	"github.com/nagelflorian/statuspage-go/internal/yaml"
+)
+
+// Config is the desired state of a page.
+type Config struct {
+	// Page holds the page settings. Empty strings and nil booleans leave
+	// the current setting untouched.
+	Page statuspage.UpdatePageParams `json:"page"`
+
+	ComponentGroups []ComponentGroup `json:"component_groups,omitempty"`
+	Components      []Component      `json:"components,omitempty"`
+}
+
+// ComponentGroup is the desired state of a component group. Its components
+// are the Components of the Config naming it as their Group.
+type ComponentGroup struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+}
+
+// Component is the desired state of a component. An empty Description and
+// nil booleans leave the current value untouched.
+type Component struct {
+	Name               string `json:"name"`
+	Description        string `json:"description,omitempty"`
+	Group              string `json:"group,omitempty"`
+	Showcase           *bool  `json:"showcase,omitempty"`
+	OnlyShowIfDegraded *bool  `json:"only_show_if_degraded,omitempty"`
+}
+
+// Parse parses a Config from a JSON or YAML document. Unknown fields are
+// rejected so that typos do not go unnoticed. In YAML, unquoted values that
+// read as numbers, such as name: 2024, are taken as strings.
+func Parse(data []byte) (*Config, error) {
+	config := &Config{}
+	var err error
+	if trimmed := bytes.TrimSpace(data); len(trimmed) > 0 && trimmed[0] == '{' {
+		dec := json.NewDecoder(bytes.NewReader(data))
+		dec.DisallowUnknownFields()
+		err = dec.Decode(config)
+	} else {
+		err = yaml.Unmarshal(data, config)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("pagesync: invalid config: %w", err)
+	}
+	if err := config.validate(); err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+// validate checks that names are set and unique, that components only name
+// groups of the Config and that every group has components, which the API
+// requires.
+func (c *Config) validate() error {
+	groups := map[string]int{}
+	for _, g := range c.ComponentGroups {
+		if g.Name == "" {
+			return fmt.Errorf("pagesync: component group without name")
+		}
+		if _, ok := groups[g.Name]; ok {
+			return fmt.Errorf("pagesync: duplicate component group %q", g.Name)
+		}
+		groups[g.Name] = 0
+	}
+
+	components := map[string]bool{}
+	for _, comp := range c.Components {
+		if comp.Name == "" {
+			return fmt.Errorf("pagesync: component without name")
+		}
+		if components[comp.Name] {
+			return fmt.Errorf("pagesync: duplicate component %q", comp.Name)
+		}
+		components[comp.Name] = true
+		if comp.Group == "" {
+			continue
+		}
+		if _, ok := groups[comp.Group]; !ok {
+			return fmt.Errorf("pagesync: component %q names unknown group %q", comp.Name, comp.Group)
+		}
+		groups[comp.Group]++
+	}
+
+	for _, g := range c.ComponentGroups {
+		if groups[g.Name] == 0 {
+			return fmt.Errorf("pagesync: component group %q has no components", g.Name)
+		}
+	}
+	return nil
+}
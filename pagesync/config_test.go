@@ -0,0 +1,99 @@
+package pagesync
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/nagelflorian/statuspage-go"
+)
+
+func TestParse(t *testing.T) {
+	yamlConfig := `
+page:
+  name: Example
+  hidden_from_search: true
+component_groups:
+- name: Backend
+  description: Servers
+components:
+- name: API
+  group: Backend
+  showcase: true
+- name: Website
+`
+	jsonConfig := `{
+	"page": {"name": "Example", "hidden_from_search": true},
+	"component_groups": [{"name": "Backend", "description": "Servers"}],
+	"components": [{"name": "API", "group": "Backend", "showcase": true}, {"name": "Website"}]
+}`
+
+	want := &Config{
+		Page: statuspage.UpdatePageParams{Name: "Example", HiddenFromSearch: Bool(true)},
+		ComponentGroups: []ComponentGroup{
+			{Name: "Backend", Description: "Servers"},
+		},
+		Components: []Component{
+			{Name: "API", Group: "Backend", Showcase: Bool(true)},
+			{Name: "Website"},
+		},
+	}
+
+	for name, data := range map[string]string{"yaml": yamlConfig, "json": jsonConfig} {
+		got, err := Parse([]byte(data))
+		if err != nil {
+			t.Fatalf("Parse(%s) returned error: %v", name, err)
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("Parse(%s) returned %+v, want %+v", name, got, want)
+		}
+	}
+}
+
+func TestParse_numericStrings(t *testing.T) {
+	config := `
+page:
+  name: 2024
+components:
+- name: 1.0
+  description: 42
+`
+	got, err := Parse([]byte(config))
+	if err != nil {
+		t.Fatalf("Parse returned error: %v", err)
+	}
+	want := &Config{
+		Page:       statuspage.UpdatePageParams{Name: "2024"},
+		Components: []Component{{Name: "1.0", Description: "42"}},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Parse returned %+v, want %+v", got, want)
+	}
+}
+
+func TestParse_errors(t *testing.T) {
+	tests := []struct {
+		config string
+		want   string
+	}{
+		{"page:\n  nmae: Example\n", "unknown field"},
+		{"components:\n- description: No name\n", "component without name"},
+		{"components:\n- name: API\n- name: API\n", `duplicate component "API"`},
+		{"component_groups:\n- name: A\n- name: A\ncomponents:\n- name: API\n  group: A\n", `duplicate component group "A"`},
+		{"components:\n- name: API\n  group: Backend\n", `unknown group "Backend"`},
+		{"component_groups:\n- name: Backend\n", `group "Backend" has no components`},
+		{"page: [\n", "yaml"},
+		{"components:\n- name: API\n  showcase: 1\n", "cannot unmarshal number"},
+	}
+
+	for _, tt := range tests {
+		_, err := Parse([]byte(tt.config))
+		if err == nil || !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("Parse(%q) returned error %v, want error containing %q", tt.config, err, tt.want)
+		}
+	}
+}
+
+func Bool(v bool) *bool { return &v }
+
+func String(v string) *string { return &v }
@@ -0,0 +1,494 @@
+package pagesync
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/nagelflorian/statuspage-go"
+)
+
+// Action is the kind of a Change.
+type Action string
+
+// Actions of a Change.
+const (
+	Create Action = "create"
+	Update Action = "update"
+	Delete Action = "delete"
+)
+
+// Resources of a Change.
+const (
+	ResourcePage           = "page"
+	ResourceComponentGroup = "component_group"
+	ResourceComponent      = "component"
+)
+
+// Change is a single API call needed to reach the desired state.
+type Change struct {
+	Action   Action        `json:"action"`
+	Resource string        `json:"resource"`
+	Name     string        `json:"name"`
+	ID       string        `json:"id,omitempty"`
+	Fields   []FieldChange `json:"fields,omitempty"`
+
+	apply func(ctx context.Context, client *statuspage.Client, s *state) error
+}
+
+// FieldChange is a changed field of a Change. From is empty for created
+// resources.
+type FieldChange struct {
+	Field string `json:"field"`
+	From  string `json:"from,omitempty"`
+	To    string `json:"to"`
+}
+
+// Plan is the list of changes that bring a page to the state of a Config,
+// in the order Apply makes them.
+type Plan struct {
+	PageID  string   `json:"page_id"`
+	Changes []Change `json:"changes"`
+
+	// Warnings are differences that cannot be applied, such as turning off
+	// the showcase of a component, which the API does not allow to send.
+	Warnings []string `json:"warnings,omitempty"`
+
+	components map[string]string // component ids by name
+}
+
+// state is the page and its component ids by name while a Plan is applied.
+type state struct {
+	pageID     string
+	components map[string]string
+}
+
+// componentIDs returns the ids of the named components.
+func (s *state) componentIDs(names []string) ([]string, error) {
+	ids := make([]string, 0, len(names))
+	for _, name := range names {
+		id, ok := s.components[name]
+		if !ok {
+			return nil, fmt.Errorf("unknown component %q", name)
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+// NewPlan compares the page with config and returns the changes needed to
+// reach the state it describes.
+func NewPlan(ctx context.Context, client *statuspage.Client, pageID string, config *Config) (*Plan, error) {
+	if err := config.validate(); err != nil {
+		return nil, err
+	}
+
+	page, _, err := client.Page.GetPage(ctx, pageID)
+	if err != nil {
+		return nil, fmt.Errorf("pagesync: getting page: %w", err)
+	}
+
+	var components []statuspage.Component
+	for component, err := range client.Component.All(ctx, pageID) {
+		if err != nil {
+			return nil, fmt.Errorf("pagesync: listing components: %w", err)
+		}
+		// Component groups are listed as components too.
+		if component.Group != nil && *component.Group {
+			continue
+		}
+		components = append(components, component)
+	}
+
+	var groups []statuspage.ComponentGroup
+	for group, err := range client.ComponentGroup.All(ctx, pageID) {
+		if err != nil {
+			return nil, fmt.Errorf("pagesync: listing component groups: %w", err)
+		}
+		groups = append(groups, group)
+	}
+
+	p := &Plan{
+		PageID:     pageID,
+		components: map[string]string{},
+	}
+	if err := p.diff(page, components, groups, config); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// diff fills the changes of p. Changes are ordered so that components exist
+// before groups list them and groups no longer list components when they
+// are deleted.
+func (p *Plan) diff(page *statuspage.Page, components []statuspage.Component, groups []statuspage.ComponentGroup, config *Config) error {
+	current := map[string]statuspage.Component{}
+	names := map[string]string{} // component names by id
+	for _, c := range components {
+		name := str(c.Name)
+		if _, ok := current[name]; ok {
+			return fmt.Errorf("pagesync: page has several components named %q", name)
+		}
+		current[name] = c
+		p.components[name] = str(c.ID)
+		names[str(c.ID)] = name
+	}
+	currentGroups := map[string]statuspage.ComponentGroup{}
+	for _, g := range groups {
+		name := str(g.Name)
+		if _, ok := currentGroups[name]; ok {
+			return fmt.Errorf("pagesync: page has several component groups named %q", name)
+		}
+		currentGroups[name] = g
+	}
+
+	members := map[string][]string{} // desired component names by group name
+	for _, c := range config.Components {
+		if c.Group != "" {
+			members[c.Group] = append(members[c.Group], c.Name)
+		}
+	}
+
+	var updates, deletes []Change
+	if change, ok := diffPage(page, config.Page); ok {
+		updates = append(updates, change)
+	}
+
+	var componentCreates, componentUpdates, groupCreates []Change
+	desired := map[string]bool{}
+	for _, c := range config.Components {
+		desired[c.Name] = true
+		cur, ok := current[c.Name]
+		if !ok {
+			componentCreates = append(componentCreates, createComponent(c))
+			continue
+		}
+		change, warnings := diffComponent(cur, c)
+		p.Warnings = append(p.Warnings, warnings...)
+		if len(change.Fields) > 0 {
+			componentUpdates = append(componentUpdates, change)
+		}
+	}
+
+	var groupUpdates []Change
+	desiredGroups := map[string]bool{}
+	for _, g := range config.ComponentGroups {
+		desiredGroups[g.Name] = true
+		cur, ok := currentGroups[g.Name]
+		if !ok {
+			groupCreates = append(groupCreates, createGroup(g, members[g.Name]))
+			continue
+		}
+		var currentMembers []string
+		for _, id := range cur.Components {
+			if name, ok := names[id]; ok {
+				currentMembers = append(currentMembers, name)
+			}
+		}
+		if change := diffGroup(cur, g, currentMembers, members[g.Name]); len(change.Fields) > 0 {
+			groupUpdates = append(groupUpdates, change)
+		}
+	}
+
+	for _, c := range components {
+		if !desired[str(c.Name)] {
+			deletes = append(deletes, deleteComponent(c))
+		}
+	}
+	for _, g := range groups {
+		if !desiredGroups[str(g.Name)] {
+			deletes = append(deletes, deleteGroup(g))
+		}
+	}
+
+	p.Changes = slices.Concat(updates, componentCreates, groupCreates, groupUpdates, componentUpdates, deletes)
+	return nil
+}
+
+// diffPage returns the update of the page settings set in desired that
+// differ from page.
+func diffPage(page *statuspage.Page, desired statuspage.UpdatePageParams) (Change, bool) {
+	change := Change{Action: Update, Resource: ResourcePage, Name: str(page.Name), ID: str(page.ID)}
+	var params statuspage.UpdatePageParams
+
+	for _, f := range []struct {
+		field   string
+		cur     *string
+		desired string
+		param   *string
+	}{
+		{"name", page.Name, desired.Name, &params.Name},
+		{"domain", page.Domain, desired.Domain, &params.Domain},
+		{"subdomain", page.Subdomain, desired.Subdomain, &params.Subdomain},
+		{"url", page.URL, desired.URL, &params.URL},
+		{"branding", page.Branding, desired.Branding, &params.Branding},
+		{"css_body_background_color", page.CSSBodyBackgroundColor, desired.CSSBodyBackgroundColor, &params.CSSBodyBackgroundColor},
+		{"css_font_color", page.CSSFontColor, desired.CSSFontColor, &params.CSSFontColor},
+		{"css_light_font_color", page.CSSLightFontColor, desired.CSSLightFontColor, &params.CSSLightFontColor},
+		{"css_greens", page.CSSGreens, desired.CSSGreens, &params.CSSGreens},
+		{"css_yellows", page.CSSYellows, desired.CSSYellows, &params.CSSYellows},
+		{"css_oranges", page.CSSOranges, desired.CSSOranges, &params.CSSOranges},
+		{"css_reds", page.CSSReds, desired.CSSReds, &params.CSSReds},
+		{"css_blues", page.CSSBlues, desired.CSSBlues, &params.CSSBlues},
+		{"css_border_color", page.CSSBorderColor, desired.CSSBorderColor, &params.CSSBorderColor},
+		{"css_graph_color", page.CSSGraphColor, desired.CSSGraphColor, &params.CSSGraphColor},
+		{"css_link_color", page.CSSLinkColor, desired.CSSLinkColor, &params.CSSLinkColor},
+		{"notifications_from_email", page.NotificationsFromEmail, desired.NotificationsFromEmail, &params.NotificationsFromEmail},
+		{"time_zone", page.TimeZone, desired.TimeZone, &params.TimeZone},
+		{"notifications_email_footer", page.NotificationsEmailFooter, desired.NotificationsEmailFooter, &params.NotificationsEmailFooter},
+	} {
+		if f.desired == "" || f.cur != nil && *f.cur == f.desired {
+			continue
+		}
+		*f.param = f.desired
+		fc := FieldChange{Field: f.field, To: strconv.Quote(f.desired)}
+		if f.cur != nil {
+			fc.From = strconv.Quote(*f.cur)
+		}
+		change.Fields = append(change.Fields, fc)
+	}
+
+	for _, f := range []struct {
+		field   string
+		cur     *bool
+		desired *bool
+		param   **bool
+	}{
+		{"hidden_from_search", page.HiddenFromSearch, desired.HiddenFromSearch, &params.HiddenFromSearch},
+		{"viewers_must_be_team_members", page.ViewersMustBeTeamMembers, desired.ViewersMustBeTeamMembers, &params.ViewersMustBeTeamMembers},
+		{"allow_page_subscribers", page.AllowPageSubscribers, desired.AllowPageSubscribers, &params.AllowPageSubscribers},
+		{"allow_incident_subscribers", page.AllowIncidentSubscribers, desired.AllowIncidentSubscribers, &params.AllowIncidentSubscribers},
+		{"allow_email_subscribers", page.AllowEmailSubscribers, desired.AllowEmailSubscribers, &params.AllowEmailSubscribers},
+		{"allow_sms_subscribers", page.AllowSmsSubscribers, desired.AllowSmsSubscribers, &params.AllowSmsSubscribers},
+		{"allow_rss_atom_feeds", page.AllowRssAtomFeeds, desired.AllowRssAtomFeeds, &params.AllowRssAtomFeeds},
+		{"allow_webhook_subscribers", page.AllowWebhookSubscribers, desired.AllowWebhookSubscribers, &params.AllowWebhookSubscribers},
+	} {
+		if f.desired == nil || f.cur != nil && *f.cur == *f.desired {
+			continue
+		}
+		*f.param = f.desired
+		fc := FieldChange{Field: f.field, To: strconv.FormatBool(*f.desired)}
+		if f.cur != nil {
+			fc.From = strconv.FormatBool(*f.cur)
+		}
+		change.Fields = append(change.Fields, fc)
+	}
+
+	if len(change.Fields) == 0 {
+		return change, false
+	}
+
+	change.apply = func(ctx context.Context, client *statuspage.Client, s *state) error {
+		_, _, err := client.Page.UpdatePage(ctx, s.pageID, params)
+		return err
+	}
+	return change, true
+}
+
+func createComponent(c Component) Change {
+	change := Change{Action: Create, Resource: ResourceComponent, Name: c.Name}
+	params := statuspage.CreateComponentParams{
+		Name:        c.Name,
+		Description: c.Description,
+	}
+	if c.Description != "" {
+		change.Fields = append(change.Fields, FieldChange{Field: "description", To: strconv.Quote(c.Description)})
+	}
+	if c.Showcase != nil {
+		params.Showcase = *c.Showcase
+		change.Fields = append(change.Fields, FieldChange{Field: "showcase", To: strconv.FormatBool(*c.Showcase)})
+	}
+	if c.OnlyShowIfDegraded != nil {
+		params.OnlyShowIfDegraded = *c.OnlyShowIfDegraded
+		change.Fields = append(change.Fields, FieldChange{Field: "only_show_if_degraded", To: strconv.FormatBool(*c.OnlyShowIfDegraded)})
+	}
+
+	change.apply = func(ctx context.Context, client *statuspage.Client, s *state) error {
+		created, _, err := client.Component.CreateComponent(ctx, s.pageID, params)
+		if err != nil {
+			return err
+		}
+		s.components[c.Name] = str(created.ID)
+		return nil
+	}
+	return change
+}
+
+// diffComponent returns the update of cur to desired, and warnings about
+// differences that cannot be applied.
+func diffComponent(cur statuspage.Component, desired Component) (Change, []string) {
+	change := Change{Action: Update, Resource: ResourceComponent, Name: desired.Name, ID: str(cur.ID)}
+	var params statuspage.UpdateComponentParams
+	var warnings []string
+
+	if desired.Description != "" && desired.Description != str(cur.Description) {
+		params.Description = desired.Description
+		change.Fields = append(change.Fields, FieldChange{
+			Field: "description",
+			From:  strconv.Quote(str(cur.Description)),
+			To:    strconv.Quote(desired.Description),
+		})
+	}
+	for _, b := range []struct {
+		field   string
+		cur     *bool
+		desired *bool
+		param   *bool
+	}{
+		{"showcase", cur.Showcase, desired.Showcase, &params.Showcase},
+		{"only_show_if_degraded", cur.OnlyShowIfDegraded, desired.OnlyShowIfDegraded, &params.OnlyShowIfDegraded},
+	} {
+		from := b.cur != nil && *b.cur
+		if b.desired == nil || *b.desired == from {
+			continue
+		}
+		if !*b.desired {
+			warnings = append(warnings, fmt.Sprintf("cannot set %s of component %q to false, change it on the page", b.field, desired.Name))
+			continue
+		}
+		*b.param = true
+		change.Fields = append(change.Fields, FieldChange{Field: b.field, From: "false", To: "true"})
+	}
+
+	change.apply = func(ctx context.Context, client *statuspage.Client, s *state) error {
+		_, _, err := client.Component.UpdateComponent(ctx, s.pageID, change.ID, params)
+		return err
+	}
+	return change, warnings
+}
+
+func deleteComponent(c statuspage.Component) Change {
+	change := Change{Action: Delete, Resource: ResourceComponent, Name: str(c.Name), ID: str(c.ID)}
+	change.apply = func(ctx context.Context, client *statuspage.Client, s *state) error {
+		_, err := client.Component.DeleteComponent(ctx, s.pageID, change.ID)
+		return err
+	}
+	return change
+}
+
+func createGroup(g ComponentGroup, members []string) Change {
+	change := Change{Action: Create, Resource: ResourceComponentGroup, Name: g.Name}
+	if g.Description != "" {
+		change.Fields = append(change.Fields, FieldChange{Field: "description", To: strconv.Quote(g.Description)})
+	}
+	change.Fields = append(change.Fields, FieldChange{Field: "components", To: formatList(members)})
+
+	change.apply = func(ctx context.Context, client *statuspage.Client, s *state) error {
+		ids, err := s.componentIDs(members)
+		if err != nil {
+			return err
+		}
+		_, _, err = client.ComponentGroup.CreateComponentGroup(ctx, s.pageID, statuspage.CreateComponentGroupParams{
+			Name:        g.Name,
+			Description: g.Description,
+			Components:  ids,
+		})
+		return err
+	}
+	return change
+}
+
+// diffGroup returns the update of cur to desired. The update always sends
+// the name and all components of the group, as the API requires.
+func diffGroup(cur statuspage.ComponentGroup, desired ComponentGroup, currentMembers, members []string) Change {
+	change := Change{Action: Update, Resource: ResourceComponentGroup, Name: desired.Name, ID: str(cur.ID)}
+	description := str(cur.Description)
+	if desired.Description != "" && desired.Description != description {
+		description = desired.Description
+		change.Fields = append(change.Fields, FieldChange{
+			Field: "description",
+			From:  strconv.Quote(str(cur.Description)),
+			To:    strconv.Quote(desired.Description),
+		})
+	}
+	if !slices.Equal(slices.Sorted(slices.Values(currentMembers)), slices.Sorted(slices.Values(members))) {
+		change.Fields = append(change.Fields, FieldChange{
+			Field: "components",
+			From:  formatList(currentMembers),
+			To:    formatList(members),
+		})
+	}
+
+	change.apply = func(ctx context.Context, client *statuspage.Client, s *state) error {
+		ids, err := s.componentIDs(members)
+		if err != nil {
+			return err
+		}
+		_, _, err = client.ComponentGroup.UpdateComponentGroup(ctx, s.pageID, change.ID, statuspage.UpdateComponentGroupParams{
+			Name:        desired.Name,
+			Description: description,
+			Components:  ids,
+		})
+		return err
+	}
+	return change
+}
+
+func deleteGroup(g statuspage.ComponentGroup) Change {
+	change := Change{Action: Delete, Resource: ResourceComponentGroup, Name: str(g.Name), ID: str(g.ID)}
+	change.apply = func(ctx context.Context, client *statuspage.Client, s *state) error {
+		_, err := client.ComponentGroup.DeleteComponentGroup(ctx, s.pageID, change.ID)
+		return err
+	}
+	return change
+}
+
+// Empty reports whether the page already has the desired state.
+func (p *Plan) Empty() bool {
+	return len(p.Changes) == 0
+}
+
+// String returns the plan in a human-readable form, one change per line
+// followed by its changed fields:
+//
+//	~ page "Example" (abc123)
+//	    name: "Old" -> "Example"
+//	+ component "Database"
+//	- component "Legacy" (def456)
+func (p *Plan) String() string {
+	var b strings.Builder
+	if p.Empty() {
+		b.WriteString("No changes.\n")
+	}
+	symbols := map[Action]string{Create: "+", Update: "~", Delete: "-"}
+	counts := map[Action]int{}
+	for _, c := range p.Changes {
+		counts[c.Action]++
+		fmt.Fprintf(&b, "%s %s %q", symbols[c.Action], c.Resource, c.Name)
+		if c.ID != "" {
+			fmt.Fprintf(&b, " (%s)", c.ID)
+		}
+		b.WriteString("\n")
+		for _, f := range c.Fields {
+			if f.From == "" {
+				fmt.Fprintf(&b, "    %s: %s\n", f.Field, f.To)
+			} else {
+				fmt.Fprintf(&b, "    %s: %s -> %s\n", f.Field, f.From, f.To)
+			}
+		}
+	}
+	if !p.Empty() {
+		fmt.Fprintf(&b, "\nPlan: %d to create, %d to update, %d to delete.\n", counts[Create], counts[Update], counts[Delete])
+	}
+	for _, w := range p.Warnings {
+		fmt.Fprintf(&b, "Warning: %s\n", w)
+	}
+	return b.String()
+}
+
+func str(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+func formatList(names []string) string {
+	quoted := make([]string, len(names))
+	for i, name := range names {
+		quoted[i] = strconv.Quote(name)
+	}
+	return "[" + strings.Join(quoted, ", ") + "]"
+}
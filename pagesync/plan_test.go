@@ -0,0 +1,241 @@
+package pagesync
+
+import (
+	"context"
+	"iter"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/nagelflorian/statuspage-go"
+	"github.com/nagelflorian/statuspage-go/statuspagemock"
+)
+
+// newServices returns mocks serving the given page, components and groups,
+// and accepting all changes.
+func newServices(page statuspage.Page, components []statuspage.Component, groups []statuspage.ComponentGroup) *statuspagemock.Services {
+	s := statuspagemock.NewServices()
+	s.Page.GetPageFunc = func(ctx context.Context, pageID string) (*statuspage.Page, *statuspage.Response, error) {
+		return &page, nil, nil
+	}
+	s.Page.UpdatePageFunc = func(ctx context.Context, pageID string, params statuspage.UpdatePageParams) (*statuspage.Page, *statuspage.Response, error) {
+		return &page, nil, nil
+	}
+	s.Component.AllFunc = func(ctx context.Context, pageID string) iter.Seq2[statuspage.Component, error] {
+		return seq(components)
+	}
+	s.Component.CreateComponentFunc = func(ctx context.Context, pageID string, params statuspage.CreateComponentParams) (*statuspage.Component, *statuspage.Response, error) {
+		return &statuspage.Component{ID: String("new-" + params.Name), Name: &params.Name}, nil, nil
+	}
+	s.Component.UpdateComponentFunc = func(ctx context.Context, pageID string, componentID string, params statuspage.UpdateComponentParams) (*statuspage.Component, *statuspage.Response, error) {
+		return &statuspage.Component{ID: &componentID}, nil, nil
+	}
+	s.Component.DeleteComponentFunc = func(ctx context.Context, pageID string, componentID string) (*statuspage.Response, error) {
+		return nil, nil
+	}
+	s.ComponentGroup.AllFunc = func(ctx context.Context, pageID string) iter.Seq2[statuspage.ComponentGroup, error] {
+		return seq(groups)
+	}
+	s.ComponentGroup.CreateComponentGroupFunc = func(ctx context.Context, pageID string, params statuspage.CreateComponentGroupParams) (*statuspage.ComponentGroup, *statuspage.Response, error) {
+		return &statuspage.ComponentGroup{ID: String("new-" + params.Name), Name: &params.Name}, nil, nil
+	}
+	s.ComponentGroup.UpdateComponentGroupFunc = func(ctx context.Context, pageID string, groupID string, params statuspage.UpdateComponentGroupParams) (*statuspage.ComponentGroup, *statuspage.Response, error) {
+		return &statuspage.ComponentGroup{ID: &groupID}, nil, nil
+	}
+	s.ComponentGroup.DeleteComponentGroupFunc = func(ctx context.Context, pageID string, groupID string) (*statuspage.Response, error) {
+		return nil, nil
+	}
+	return s
+}
+
+func seq[T any](items []T) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		for _, item := range items {
+			if !yield(item, nil) {
+				return
+			}
+		}
+	}
+}
+
+// examplePage returns a page with the components API, Database and Legacy,
+// where API and Database are in the group Backend, and the empty group Old.
+func examplePage() (statuspage.Page, []statuspage.Component, []statuspage.ComponentGroup) {
+	page := statuspage.Page{ID: String("p1"), Name: String("Example"), HiddenFromSearch: Bool(false)}
+	components := []statuspage.Component{
+		{ID: String("g1"), Name: String("Backend"), Group: Bool(true)},
+		{ID: String("c1"), Name: String("API"), Description: String("REST API"), GroupID: String("g1"), Showcase: Bool(true)},
+		{ID: String("c2"), Name: String("Database"), GroupID: String("g1")},
+		{ID: String("c3"), Name: String("Legacy")},
+	}
+	groups := []statuspage.ComponentGroup{
+		{ID: String("g1"), Name: String("Backend"), Description: String("Servers"), Components: []string{"c1", "c2"}},
+		{ID: String("g2"), Name: String("Old")},
+	}
+	return page, components, groups
+}
+
+func TestNewPlan(t *testing.T) {
+	s := newServices(examplePage())
+	config := &Config{
+		Page: statuspage.UpdatePageParams{Name: "Example", HiddenFromSearch: Bool(true), TimeZone: "UTC"},
+		ComponentGroups: []ComponentGroup{
+			{Name: "Backend", Description: "Servers"},
+			{Name: "Frontend"},
+		},
+		Components: []Component{
+			{Name: "API", Description: "REST API", Group: "Backend", Showcase: Bool(true)},
+			{Name: "Database", Description: "Postgres", Group: "Backend", Showcase: Bool(true), OnlyShowIfDegraded: Bool(false)},
+			{Name: "Website", Group: "Frontend"},
+		},
+	}
+
+	plan, err := NewPlan(context.Background(), s.Client(), "p1", config)
+	if err != nil {
+		t.Fatalf("NewPlan returned error: %v", err)
+	}
+
+	want := []Change{
+		{Action: Update, Resource: ResourcePage, Name: "Example", ID: "p1", Fields: []FieldChange{
+			{Field: "time_zone", To: `"UTC"`},
+			{Field: "hidden_from_search", From: "false", To: "true"},
+		}},
+		{Action: Create, Resource: ResourceComponent, Name: "Website"},
+		{Action: Create, Resource: ResourceComponentGroup, Name: "Frontend", Fields: []FieldChange{
+			{Field: "components", To: `["Website"]`},
+		}},
+		{Action: Update, Resource: ResourceComponent, Name: "Database", ID: "c2", Fields: []FieldChange{
+			{Field: "description", From: `""`, To: `"Postgres"`},
+			{Field: "showcase", From: "false", To: "true"},
+		}},
+		{Action: Delete, Resource: ResourceComponent, Name: "Legacy", ID: "c3"},
+		{Action: Delete, Resource: ResourceComponentGroup, Name: "Old", ID: "g2"},
+	}
+	for i := range plan.Changes {
+		plan.Changes[i].apply = nil
+	}
+	if !reflect.DeepEqual(plan.Changes, want) {
+		t.Errorf("NewPlan returned changes %+v, want %+v", plan.Changes, want)
+	}
+	if len(plan.Warnings) != 0 {
+		t.Errorf("NewPlan returned warnings %q, want none", plan.Warnings)
+	}
+}
+
+// TestDiffPage_allFields fails when UpdatePageParams gains a field that
+// diffPage does not compare.
+func TestDiffPage_allFields(t *testing.T) {
+	var desired statuspage.UpdatePageParams
+	v := reflect.ValueOf(&desired).Elem()
+	for i := 0; i < v.NumField(); i++ {
+		switch f := v.Field(i); f.Kind() {
+		case reflect.String:
+			f.SetString("x")
+		case reflect.Pointer:
+			f.Set(reflect.ValueOf(Bool(true)))
+		default:
+			t.Fatalf("UpdatePageParams.%s has unexpected kind %s", v.Type().Field(i).Name, f.Kind())
+		}
+	}
+
+	change, ok := diffPage(&statuspage.Page{}, desired)
+	if !ok || len(change.Fields) != v.NumField() {
+		t.Errorf("diffPage returned %d changed fields, want %d", len(change.Fields), v.NumField())
+	}
+}
+
+func TestNewPlan_membership(t *testing.T) {
+	s := newServices(examplePage())
+	config := &Config{
+		ComponentGroups: []ComponentGroup{{Name: "Backend"}},
+		Components: []Component{
+			{Name: "API", Group: "Backend", Showcase: Bool(false)},
+			{Name: "Database"},
+			{Name: "Legacy", Group: "Backend"},
+		},
+	}
+
+	plan, err := NewPlan(context.Background(), s.Client(), "p1", config)
+	if err != nil {
+		t.Fatalf("NewPlan returned error: %v", err)
+	}
+
+	i := slices.IndexFunc(plan.Changes, func(c Change) bool { return c.Resource == ResourceComponentGroup && c.Action == Update })
+	if i < 0 {
+		t.Fatalf("NewPlan returned no group update: %+v", plan.Changes)
+	}
+	want := []FieldChange{{Field: "components", From: `["API", "Database"]`, To: `["API", "Legacy"]`}}
+	if got := plan.Changes[i].Fields; !reflect.DeepEqual(got, want) {
+		t.Errorf("NewPlan returned group fields %+v, want %+v", got, want)
+	}
+	if len(plan.Warnings) != 1 || !strings.Contains(plan.Warnings[0], `showcase of component "API"`) {
+		t.Errorf("NewPlan returned warnings %q, want one about the showcase of API", plan.Warnings)
+	}
+}
+
+func TestNewPlan_noChanges(t *testing.T) {
+	page, components, groups := examplePage()
+	// Groups without components cannot be part of a Config.
+	s := newServices(page, components, groups[:1])
+	config := &Config{
+		Page:            statuspage.UpdatePageParams{Name: "Example"},
+		ComponentGroups: []ComponentGroup{{Name: "Backend"}},
+		Components: []Component{
+			{Name: "API", Group: "Backend", Showcase: Bool(true)},
+			{Name: "Database", Group: "Backend"},
+			{Name: "Legacy"},
+		},
+	}
+
+	plan, err := NewPlan(context.Background(), s.Client(), "p1", config)
+	if err != nil {
+		t.Fatalf("NewPlan returned error: %v", err)
+	}
+	if !plan.Empty() {
+		t.Errorf("NewPlan returned changes %+v, want none", plan.Changes)
+	}
+	if got, want := plan.String(), "No changes.\n"; got != want {
+		t.Errorf("Plan.String returned %q, want %q", got, want)
+	}
+}
+
+func TestNewPlan_duplicateComponents(t *testing.T) {
+	page, components, groups := examplePage()
+	components = append(components, statuspage.Component{ID: String("c4"), Name: String("API")})
+	s := newServices(page, components, groups)
+
+	_, err := NewPlan(context.Background(), s.Client(), "p1", &Config{})
+	if err == nil || !strings.Contains(err.Error(), `several components named "API"`) {
+		t.Errorf("NewPlan returned error %v, want duplicate component error", err)
+	}
+}
+
+func TestPlan_String(t *testing.T) {
+	plan := &Plan{
+		PageID: "p1",
+		Changes: []Change{
+			{Action: Update, Resource: ResourcePage, Name: "Example", ID: "p1", Fields: []FieldChange{
+				{Field: "name", From: `"Old"`, To: `"Example"`},
+			}},
+			{Action: Create, Resource: ResourceComponent, Name: "Website", Fields: []FieldChange{
+				{Field: "showcase", To: "true"},
+			}},
+			{Action: Delete, Resource: ResourceComponent, Name: "Legacy", ID: "c3"},
+		},
+		Warnings: []string{"cannot set showcase of component \"API\" to false, change it on the page"},
+	}
+
+	want := `~ page "Example" (p1)
+    name: "Old" -> "Example"
++ component "Website"
+    showcase: true
+- component "Legacy" (c3)
+
+Plan: 1 to create, 1 to update, 1 to delete.
+Warning: cannot set showcase of component "API" to false, change it on the page
+`
+	if got := plan.String(); got != want {
+		t.Errorf("Plan.String returned %q, want %q", got, want)
+	}
+}
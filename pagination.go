@@ -0,0 +1,158 @@
+package statuspage
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// defaultPerPage is the page size used by the auto-paging iterators. It is
+// the largest page size the Statuspage API accepts.
+const defaultPerPage = 100
+
+// ListOptions specifies the optional parameters to various List methods that
+// support pagination.
+type ListOptions struct {
+	// For paginated result sets, page of results to retrieve, starting at 1.
+	Page int `url:"page,omitempty"`
+
+	// For paginated result sets, the number of results to include per page.
+	PerPage int `url:"per_page,omitempty"`
+}
+
+// addOptions adds the parameters in opts as URL query parameters to s. opts
+// must be a struct, or a pointer to one, whose fields are tagged with `url`.
+// Fields are omitted if they have the zero value and the omitempty option.
+// Embedded structs such as ListOptions are flattened.
+func addOptions(s string, opts interface{}) (string, error) {
+	v := reflect.ValueOf(opts)
+	if !v.IsValid() || v.Kind() == reflect.Ptr && v.IsNil() {
+		return s, nil
+	}
+
+	u, err := url.Parse(s)
+	if err != nil {
+		return s, err
+	}
+
+	qs := u.Query()
+	if err := encodeValues(qs, reflect.Indirect(v)); err != nil {
+		return s, err
+	}
+
+	u.RawQuery = qs.Encode()
+	return u.String(), nil
+}
+
+func encodeValues(qs url.Values, v reflect.Value) error {
+	if v.Kind() != reflect.Struct {
+		return fmt.Errorf("query options must be a struct, got %v", v.Kind())
+	}
+
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		fv := v.Field(i)
+
+		if field.Anonymous {
+			if fv.Kind() == reflect.Ptr {
+				if fv.IsNil() {
+					continue
+				}
+				fv = fv.Elem()
+			}
+			if err := encodeValues(qs, fv); err != nil {
+				return err
+			}
+			continue
+		}
+
+		tag := field.Tag.Get("url")
+		if tag == "" || tag == "-" {
+			continue
+		}
+		name, opt, _ := strings.Cut(tag, ",")
+		if opt == "omitempty" && fv.IsZero() {
+			continue
+		}
+
+		if fv.Kind() == reflect.Ptr {
+			if fv.IsNil() {
+				continue
+			}
+			fv = fv.Elem()
+		}
+
+		switch fv.Kind() {
+		case reflect.String:
+			qs.Set(name, fv.String())
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			qs.Set(name, strconv.FormatInt(fv.Int(), 10))
+		case reflect.Bool:
+			qs.Set(name, strconv.FormatBool(fv.Bool()))
+		case reflect.Slice:
+			for j := 0; j < fv.Len(); j++ {
+				qs.Add(name+"[]", fmt.Sprint(fv.Index(j).Interface()))
+			}
+		default:
+			qs.Set(name, fmt.Sprint(fv.Interface()))
+		}
+	}
+
+	return nil
+}
+
+// listFunc fetches a single page of results.
+type listFunc[T any] func(ctx context.Context, opts *ListOptions) (*[]T, *Response, error)
+
+// allPages returns an iterator over every result of a paginated list
+// endpoint. It fetches the next page once the current one is consumed and
+// stops after a page with fewer than PerPage results. Errors, including
+// cancellation of ctx, are yielded once and end the iteration.
+//
+// Endpoints that ignore the page parameters would otherwise be fetched
+// forever, so iteration also stops after a page with more than PerPage
+// results, which holds all of them, and before a page starting with the same
+// result as the previous one.
+func allPages[T any](ctx context.Context, list listFunc[T]) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var zero T
+		var first *T
+		opts := &ListOptions{Page: 1, PerPage: defaultPerPage}
+
+		for {
+			if err := ctx.Err(); err != nil {
+				yield(zero, err)
+				return
+			}
+
+			items, _, err := list(ctx, opts)
+			if err != nil {
+				yield(zero, err)
+				return
+			}
+
+			if len(*items) > 0 {
+				if first != nil && reflect.DeepEqual(*first, (*items)[0]) {
+					return
+				}
+				first = &(*items)[0]
+			}
+
+			for _, item := range *items {
+				if !yield(item, nil) {
+					return
+				}
+			}
+
+			if len(*items) != opts.PerPage {
+				return
+			}
+			opts.Page++
+		}
+	}
+}
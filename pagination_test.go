@@ -0,0 +1,251 @@
+package statuspage
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strconv"
+	"testing"
+)
+
+func TestAddOptions(t *testing.T) {
+	type options struct {
+		Q     string   `url:"q,omitempty"`
+		Flag  *bool    `url:"flag,omitempty"`
+		Types []string `url:"types,omitempty"`
+		Skip  string
+		ListOptions
+	}
+
+	tests := []struct {
+		opts interface{}
+		want string
+	}{
+		{nil, "v1/pages"},
+		{(*ListOptions)(nil), "v1/pages"},
+		{&ListOptions{}, "v1/pages"},
+		{&ListOptions{Page: 2, PerPage: 50}, "v1/pages?page=2&per_page=50"},
+		{
+			&options{Q: "a b", Flag: Bool(false), Types: []string{"c", "d"}, Skip: "e", ListOptions: ListOptions{Page: 3}},
+			"v1/pages?flag=false&page=3&q=a+b&types%5B%5D=c&types%5B%5D=d",
+		},
+	}
+
+	for _, tt := range tests {
+		got, err := addOptions("v1/pages", tt.opts)
+		if err != nil {
+			t.Errorf("addOptions(%v) returned error: %v", tt.opts, err)
+		}
+		if got != tt.want {
+			t.Errorf("addOptions(%v) = %v, want %v", tt.opts, got, tt.want)
+		}
+	}
+}
+
+func TestComponentService_ListComponents_options(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v1/pages/1/components", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if got, want := r.URL.Query().Get("page"), "2"; got != want {
+			t.Errorf("page = %v, want %v", got, want)
+		}
+		if got, want := r.URL.Query().Get("per_page"), "10"; got != want {
+			t.Errorf("per_page = %v, want %v", got, want)
+		}
+		fmt.Fprint(w, `[{"id":"1"}]`)
+	})
+
+	opts := &ListOptions{Page: 2, PerPage: 10}
+	_, _, err := client.Component.ListComponents(context.Background(), "1", opts)
+	if err != nil {
+		t.Errorf("ComponentService.ListComponents returned error: %v", err)
+	}
+}
+
+// handlePages serves total components split into pages of per_page results.
+func handlePages(t *testing.T, total int, requests *int) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		*requests++
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		perPage, _ := strconv.Atoi(r.URL.Query().Get("per_page"))
+		if page < 1 || perPage != defaultPerPage {
+			t.Errorf("Unexpected pagination parameters %v", r.URL.Query())
+		}
+
+		fmt.Fprint(w, "[")
+		for i := (page - 1) * perPage; i < page*perPage && i < total; i++ {
+			if i > (page-1)*perPage {
+				fmt.Fprint(w, ",")
+			}
+			fmt.Fprintf(w, `{"id":"%d"}`, i)
+		}
+		fmt.Fprint(w, "]")
+	}
+}
+
+func TestComponentService_All(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	var requests int
+	mux.HandleFunc("/v1/pages/1/components", handlePages(t, 2*defaultPerPage+1, &requests))
+
+	var ids []string
+	for component, err := range client.Component.All(context.Background(), "1") {
+		if err != nil {
+			t.Fatalf("ComponentService.All returned error: %v", err)
+		}
+		ids = append(ids, *component.ID)
+	}
+
+	if len(ids) != 2*defaultPerPage+1 {
+		t.Errorf("ComponentService.All returned %d components, want %d", len(ids), 2*defaultPerPage+1)
+	}
+	if ids[defaultPerPage] != strconv.Itoa(defaultPerPage) {
+		t.Errorf("ComponentService.All returned %v as first component of page 2", ids[defaultPerPage])
+	}
+	if requests != 3 {
+		t.Errorf("Expected 3 requests, got %d", requests)
+	}
+}
+
+func TestComponentService_All_pageIgnored(t *testing.T) {
+	tests := []struct {
+		count        int
+		wantRequests int
+	}{
+		// Full pages are fetched until one repeats the previous page.
+		{defaultPerPage, 2},
+		// A page larger than requested holds every result.
+		{defaultPerPage + 50, 1},
+	}
+
+	for _, tt := range tests {
+		client, mux, _, teardown := setup()
+
+		var requests int
+		mux.HandleFunc("/v1/pages/1/components", func(w http.ResponseWriter, r *http.Request) {
+			requests++
+			components := make([]Component, tt.count)
+			for i := range components {
+				components[i].ID = String(strconv.Itoa(i))
+			}
+			json.NewEncoder(w).Encode(components)
+		})
+
+		var count int
+		for _, err := range client.Component.All(context.Background(), "1") {
+			if err != nil {
+				t.Fatalf("ComponentService.All returned error: %v", err)
+			}
+			count++
+		}
+		teardown()
+
+		if count != tt.count {
+			t.Errorf("ComponentService.All returned %d components, want %d", count, tt.count)
+		}
+		if requests != tt.wantRequests {
+			t.Errorf("Expected %d requests, got %d", tt.wantRequests, requests)
+		}
+	}
+}
+
+func TestComponentService_All_break(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	var requests int
+	mux.HandleFunc("/v1/pages/1/components", handlePages(t, 3*defaultPerPage, &requests))
+
+	var count int
+	for range client.Component.All(context.Background(), "1") {
+		count++
+		if count == defaultPerPage+1 {
+			break
+		}
+	}
+
+	if requests != 2 {
+		t.Errorf("Expected 2 requests, got %d", requests)
+	}
+}
+
+func TestComponentService_All_canceled(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	var requests int
+	mux.HandleFunc("/v1/pages/1/components", handlePages(t, 3*defaultPerPage, &requests))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var count int
+	var lastErr error
+	for _, err := range client.Component.All(ctx, "1") {
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		count++
+		if count == defaultPerPage {
+			cancel()
+		}
+	}
+
+	if !errors.Is(lastErr, context.Canceled) {
+		t.Errorf("ComponentService.All yielded error %v, want %v", lastErr, context.Canceled)
+	}
+	if count != defaultPerPage || requests != 1 {
+		t.Errorf("Expected iteration to stop after the first page, got %d components in %d requests", count, requests)
+	}
+}
+
+func TestPageService_All_error(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v1/pages", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	})
+
+	var errs []error
+	for page, err := range client.Page.All(context.Background()) {
+		if err == nil {
+			t.Errorf("PageService.All yielded unexpected page %v", page)
+		}
+		errs = append(errs, err)
+	}
+
+	if len(errs) != 1 || !IsUnauthorized(errs[0]) {
+		t.Errorf("PageService.All yielded errors %v, want a single unauthorized error", errs)
+	}
+}
+
+func TestIncidentService_All(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v1/pages/1/incidents", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[{"id":"1"},{"id":"2"}]`)
+	})
+
+	var incidents []Incident
+	for incident, err := range client.Incident.All(context.Background(), "1") {
+		if err != nil {
+			t.Fatalf("IncidentService.All returned error: %v", err)
+		}
+		incidents = append(incidents, incident)
+	}
+
+	want := []Incident{{ID: String("1")}, {ID: String("2")}}
+	if !reflect.DeepEqual(incidents, want) {
+		t.Errorf("IncidentService.All returned %+v, want %+v", incidents, want)
+	}
+}
@@ -0,0 +1,100 @@
+package statuspage
+
+import "context"
+
+// Postmortem is the Statuspage API incident postmortem representation
+type Postmortem struct {
+	PreviewKey         *string    `json:"preview_key,omitempty"`
+	Body               *string    `json:"body,omitempty"`
+	BodyUpdatedAt      *Timestamp `json:"body_updated_at,omitempty"`
+	BodyDraft          *string    `json:"body_draft,omitempty"`
+	BodyDraftUpdatedAt *Timestamp `json:"body_draft_updated_at,omitempty"`
+	PublishedAt        *Timestamp `json:"published_at,omitempty"`
+	NotifySubscribers  *bool      `json:"notify_subscribers,omitempty"`
+	NotifyTwitter      *bool      `json:"notify_twitter,omitempty"`
+	CustomTweet        *string    `json:"custom_tweet,omitempty"`
+}
+
+func (p Postmortem) String() string {
+	return Stringify(p)
+}
+
+// GetPostmortem returns the postmortem for a given page and incident id
+func (s *IncidentService) GetPostmortem(ctx context.Context, pageID string, incidentID string) (*Postmortem, *Response, error) {
+	path := "v1/pages/" + pageID + "/incidents/" + incidentID + "/postmortem"
+	req, err := s.client.newRequest("GET", path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var postmortem Postmortem
+	resp, err := s.client.do(ctx, req, &postmortem)
+
+	return &postmortem, resp, err
+}
+
+// UpdatePostmortemParams are the parameters that can be set using the create or update postmortem API endpoint
+type UpdatePostmortemParams struct {
+	BodyDraft string `json:"body_draft"`
+}
+
+// UpdatePostmortemRequestBody is the create or update postmortem request body representation
+type UpdatePostmortemRequestBody struct {
+	Postmortem UpdatePostmortemParams `json:"postmortem"`
+}
+
+// CreateOrUpdatePostmortem sets the draft body of the postmortem for a given page and incident id
+func (s *IncidentService) CreateOrUpdatePostmortem(ctx context.Context, pageID string, incidentID string, bodyDraft string) (*Postmortem, *Response, error) {
+	path := "v1/pages/" + pageID + "/incidents/" + incidentID + "/postmortem"
+	payload := UpdatePostmortemRequestBody{Postmortem: UpdatePostmortemParams{BodyDraft: bodyDraft}}
+	req, err := s.client.newRequest("PUT", path, payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var postmortem Postmortem
+	resp, err := s.client.do(ctx, req, &postmortem)
+
+	return &postmortem, resp, err
+}
+
+// PublishPostmortemParams are the parameters that can be set using the publish postmortem API endpoint
+type PublishPostmortemParams struct {
+	NotifyTwitter     *bool  `json:"notify_twitter,omitempty"`
+	NotifySubscribers *bool  `json:"notify_subscribers,omitempty"`
+	CustomTweet       string `json:"custom_tweet,omitempty"`
+}
+
+// PublishPostmortemRequestBody is the publish postmortem request body representation
+type PublishPostmortemRequestBody struct {
+	Postmortem PublishPostmortemParams `json:"postmortem"`
+}
+
+// PublishPostmortem publishes the draft of the postmortem for a given page and incident id
+func (s *IncidentService) PublishPostmortem(ctx context.Context, pageID string, incidentID string, params PublishPostmortemParams) (*Postmortem, *Response, error) {
+	path := "v1/pages/" + pageID + "/incidents/" + incidentID + "/postmortem/publish"
+	payload := PublishPostmortemRequestBody{Postmortem: params}
+	req, err := s.client.newRequest("PUT", path, payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var postmortem Postmortem
+	resp, err := s.client.do(ctx, req, &postmortem)
+
+	return &postmortem, resp, err
+}
+
+// RevertPostmortem reverts the published postmortem for a given page and incident id to a draft
+func (s *IncidentService) RevertPostmortem(ctx context.Context, pageID string, incidentID string) (*Postmortem, *Response, error) {
+	path := "v1/pages/" + pageID + "/incidents/" + incidentID + "/postmortem/revert"
+	req, err := s.client.newRequest("PUT", path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var postmortem Postmortem
+	resp, err := s.client.do(ctx, req, &postmortem)
+
+	return &postmortem, resp, err
+}
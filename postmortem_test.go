@@ -0,0 +1,135 @@
+package statuspage
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestPostmortem_marshal(t *testing.T) {
+	testJSONMarshal(t, &Postmortem{}, "{}")
+
+	p := &Postmortem{
+		PreviewKey:        String("k"),
+		Body:              String("b"),
+		BodyUpdatedAt:     &Timestamp{referenceTime},
+		BodyDraft:         String("d"),
+		PublishedAt:       &Timestamp{referenceTime},
+		NotifySubscribers: Bool(true),
+		NotifyTwitter:     Bool(false),
+		CustomTweet:       String("t"),
+	}
+
+	want := `{
+		"preview_key": "k",
+		"body": "b",
+		"body_updated_at": "2006-01-02T15:04:05Z",
+		"body_draft": "d",
+		"published_at": "2006-01-02T15:04:05Z",
+		"notify_subscribers": true,
+		"notify_twitter": false,
+		"custom_tweet": "t"
+	}`
+
+	testJSONMarshal(t, p, want)
+}
+
+func TestIncidentService_GetPostmortem(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v1/pages/1/incidents/2/postmortem", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"body_draft":"d"}`)
+	})
+
+	postmortem, _, err := client.Incident.GetPostmortem(context.Background(), "1", "2")
+	if err != nil {
+		t.Errorf("IncidentService.GetPostmortem returned error: %v", err)
+	}
+
+	want := &Postmortem{BodyDraft: String("d")}
+	if !reflect.DeepEqual(postmortem, want) {
+		t.Errorf("IncidentService.GetPostmortem returned %+v, want %+v", postmortem, want)
+	}
+}
+
+func TestIncidentService_CreateOrUpdatePostmortem(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v1/pages/1/incidents/2/postmortem", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+
+		v := &UpdatePostmortemRequestBody{}
+		json.NewDecoder(r.Body).Decode(v)
+		want := UpdatePostmortemParams{BodyDraft: "d"}
+		if !reflect.DeepEqual(v.Postmortem, want) {
+			t.Errorf("Request body = %+v, want %+v", v.Postmortem, want)
+		}
+
+		fmt.Fprint(w, `{"body_draft":"d"}`)
+	})
+
+	postmortem, _, err := client.Incident.CreateOrUpdatePostmortem(context.Background(), "1", "2", "d")
+	if err != nil {
+		t.Errorf("IncidentService.CreateOrUpdatePostmortem returned error: %v", err)
+	}
+
+	want := &Postmortem{BodyDraft: String("d")}
+	if !reflect.DeepEqual(postmortem, want) {
+		t.Errorf("IncidentService.CreateOrUpdatePostmortem returned %+v, want %+v", postmortem, want)
+	}
+}
+
+func TestIncidentService_PublishPostmortem(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	input := PublishPostmortemParams{NotifySubscribers: Bool(true), NotifyTwitter: Bool(false), CustomTweet: "t"}
+
+	mux.HandleFunc("/v1/pages/1/incidents/2/postmortem/publish", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+
+		v := &PublishPostmortemRequestBody{}
+		json.NewDecoder(r.Body).Decode(v)
+		if !reflect.DeepEqual(v.Postmortem, input) {
+			t.Errorf("Request body = %+v, want %+v", v.Postmortem, input)
+		}
+
+		fmt.Fprint(w, `{"body":"b", "published_at":"2006-01-02T15:04:05Z"}`)
+	})
+
+	postmortem, _, err := client.Incident.PublishPostmortem(context.Background(), "1", "2", input)
+	if err != nil {
+		t.Errorf("IncidentService.PublishPostmortem returned error: %v", err)
+	}
+
+	want := &Postmortem{Body: String("b"), PublishedAt: &Timestamp{referenceTime}}
+	if !reflect.DeepEqual(postmortem, want) {
+		t.Errorf("IncidentService.PublishPostmortem returned %+v, want %+v", postmortem, want)
+	}
+}
+
+func TestIncidentService_RevertPostmortem(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v1/pages/1/incidents/2/postmortem/revert", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		fmt.Fprint(w, `{"body_draft":"b"}`)
+	})
+
+	postmortem, _, err := client.Incident.RevertPostmortem(context.Background(), "1", "2")
+	if err != nil {
+		t.Errorf("IncidentService.RevertPostmortem returned error: %v", err)
+	}
+
+	want := &Postmortem{BodyDraft: String("b")}
+	if !reflect.DeepEqual(postmortem, want) {
+		t.Errorf("IncidentService.RevertPostmortem returned %+v, want %+v", postmortem, want)
+	}
+}
@@ -0,0 +1,110 @@
+package statuspage
+
+import (
+	"context"
+	"net/url"
+)
+
+// PublicClient reads the public status API that every Statuspage page serves
+// under its own domain, e.g. https://status.example.com/api/v2/summary.json.
+// It needs no API token, so it works for pages of other organizations too.
+//
+// Statuspage public API docs: https://metastatuspage.com/api
+type PublicClient struct {
+	client *Client
+	domain string
+}
+
+// NewPublicClient returns a PublicClient for the status page served at
+// domain, e.g. "status.example.com". The options of NewClient apply; use
+// WithBaseURL to talk to a page that is not served over HTTPS.
+func NewPublicClient(domain string, opts ...Option) *PublicClient {
+	baseURL := &url.URL{Host: domain, Scheme: "https", Path: "/"}
+	return &PublicClient{
+		client: NewClient("", append([]Option{WithBaseURL(baseURL)}, opts...)...),
+		domain: domain,
+	}
+}
+
+// Domain returns the domain of the status page c reads.
+func (c *PublicClient) Domain() string {
+	return c.domain
+}
+
+// PublicStatus is the rollup status of a page as reported by the public
+// status API
+type PublicStatus struct {
+	// Indicator is one of none, minor, major or critical.
+	Indicator   *string `json:"indicator,omitempty"`
+	Description *string `json:"description,omitempty"`
+}
+
+func (s PublicStatus) String() string {
+	return Stringify(s)
+}
+
+// PublicSummary is the public status API summary of a page. Only the page's
+// ID, Name, URL, TimeZone and UpdatedAt are set.
+type PublicSummary struct {
+	Page                  *Page         `json:"page,omitempty"`
+	Status                *PublicStatus `json:"status,omitempty"`
+	Components            []Component   `json:"components,omitempty"`
+	Incidents             []Incident    `json:"incidents,omitempty"`
+	ScheduledMaintenances []Incident    `json:"scheduled_maintenances,omitempty"`
+}
+
+func (s PublicSummary) String() string {
+	return Stringify(s)
+}
+
+// GetSummary returns the status, components, unresolved incidents and
+// upcoming or in progress scheduled maintenances of the page
+func (c *PublicClient) GetSummary(ctx context.Context) (*PublicSummary, *Response, error) {
+	var summary PublicSummary
+	resp, err := c.get(ctx, "api/v2/summary.json", &summary)
+
+	return &summary, resp, err
+}
+
+// GetStatus returns the rollup status of the page
+func (c *PublicClient) GetStatus(ctx context.Context) (*PublicStatus, *Response, error) {
+	var summary PublicSummary
+	resp, err := c.get(ctx, "api/v2/status.json", &summary)
+
+	return summary.Status, resp, err
+}
+
+// ListComponents returns the components of the page
+func (c *PublicClient) ListComponents(ctx context.Context) (*[]Component, *Response, error) {
+	var summary PublicSummary
+	resp, err := c.get(ctx, "api/v2/components.json", &summary)
+
+	return &summary.Components, resp, err
+}
+
+// ListUnresolvedIncidents returns the incidents of the page that are not
+// resolved yet
+func (c *PublicClient) ListUnresolvedIncidents(ctx context.Context) (*[]Incident, *Response, error) {
+	var summary PublicSummary
+	resp, err := c.get(ctx, "api/v2/incidents/unresolved.json", &summary)
+
+	return &summary.Incidents, resp, err
+}
+
+// ListUpcomingMaintenances returns the scheduled maintenances of the page
+// that have not started yet
+func (c *PublicClient) ListUpcomingMaintenances(ctx context.Context) (*[]Incident, *Response, error) {
+	var summary PublicSummary
+	resp, err := c.get(ctx, "api/v2/scheduled-maintenances/upcoming.json", &summary)
+
+	return &summary.ScheduledMaintenances, resp, err
+}
+
+func (c *PublicClient) get(ctx context.Context, path string, v interface{}) (*Response, error) {
+	req, err := c.client.newRequest("GET", path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.client.do(ctx, req, v)
+}
@@ -0,0 +1,163 @@
+package statuspage
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"reflect"
+	"testing"
+)
+
+// setupPublic sets up a test HTTP server along with a PublicClient that is
+// configured to talk to that test server.
+func setupPublic() (client *PublicClient, mux *http.ServeMux, teardown func()) {
+	_, mux, serverURL, teardown := setup()
+	u, _ := url.Parse(serverURL + baseURLPath)
+	return NewPublicClient("status.example.com", WithBaseURL(u)), mux, teardown
+}
+
+func TestNewPublicClient(t *testing.T) {
+	c := NewPublicClient("status.example.com")
+
+	if got, want := c.client.BaseURL.String(), "https://status.example.com/"; got != want {
+		t.Errorf("NewPublicClient BaseURL is %v, want %v", got, want)
+	}
+	if c.client.Token != "" {
+		t.Errorf("NewPublicClient Token is %q, want none", c.client.Token)
+	}
+}
+
+func TestPublicClient_GetSummary(t *testing.T) {
+	client, mux, teardown := setupPublic()
+	defer teardown()
+
+	mux.HandleFunc("/api/v2/summary.json", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if got := r.Header.Get("Authorization"); got != "" {
+			t.Errorf("Authorization header is %q, want none", got)
+		}
+		fmt.Fprint(w, `{
+			"page": {"id": "p", "name": "Example", "url": "https://status.example.com", "time_zone": "Etc/UTC", "updated_at": "2006-01-02T15:04:05Z"},
+			"status": {"indicator": "minor", "description": "Partially Degraded Service"},
+			"components": [{"id": "c", "status": "degraded_performance"}],
+			"incidents": [{"id": "i", "status": "investigating"}],
+			"scheduled_maintenances": [{"id": "m", "status": "scheduled", "scheduled_for": "2006-01-02T15:04:05Z"}]
+		}`)
+	})
+
+	summary, _, err := client.GetSummary(context.Background())
+	if err != nil {
+		t.Errorf("PublicClient.GetSummary returned error: %v", err)
+	}
+
+	want := &PublicSummary{
+		Page: &Page{
+			ID:        String("p"),
+			Name:      String("Example"),
+			URL:       String("https://status.example.com"),
+			TimeZone:  String("Etc/UTC"),
+			UpdatedAt: &Timestamp{referenceTime},
+		},
+		Status:                &PublicStatus{Indicator: String("minor"), Description: String("Partially Degraded Service")},
+		Components:            []Component{{ID: String("c"), Status: String("degraded_performance")}},
+		Incidents:             []Incident{{ID: String("i"), Status: String("investigating")}},
+		ScheduledMaintenances: []Incident{{ID: String("m"), Status: String("scheduled"), ScheduledFor: &Timestamp{referenceTime}}},
+	}
+	if !reflect.DeepEqual(summary, want) {
+		t.Errorf("PublicClient.GetSummary returned %+v, want %+v", summary, want)
+	}
+}
+
+func TestPublicClient_GetStatus(t *testing.T) {
+	client, mux, teardown := setupPublic()
+	defer teardown()
+
+	mux.HandleFunc("/api/v2/status.json", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"page": {"id": "p"}, "status": {"indicator": "none", "description": "All Systems Operational"}}`)
+	})
+
+	status, _, err := client.GetStatus(context.Background())
+	if err != nil {
+		t.Errorf("PublicClient.GetStatus returned error: %v", err)
+	}
+
+	want := &PublicStatus{Indicator: String("none"), Description: String("All Systems Operational")}
+	if !reflect.DeepEqual(status, want) {
+		t.Errorf("PublicClient.GetStatus returned %+v, want %+v", status, want)
+	}
+}
+
+func TestPublicClient_ListComponents(t *testing.T) {
+	client, mux, teardown := setupPublic()
+	defer teardown()
+
+	mux.HandleFunc("/api/v2/components.json", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"page": {"id": "p"}, "components": [{"id": "c", "name": "API", "status": "operational"}]}`)
+	})
+
+	components, _, err := client.ListComponents(context.Background())
+	if err != nil {
+		t.Errorf("PublicClient.ListComponents returned error: %v", err)
+	}
+
+	want := &[]Component{{ID: String("c"), Name: String("API"), Status: String("operational")}}
+	if !reflect.DeepEqual(components, want) {
+		t.Errorf("PublicClient.ListComponents returned %+v, want %+v", components, want)
+	}
+}
+
+func TestPublicClient_ListUnresolvedIncidents(t *testing.T) {
+	client, mux, teardown := setupPublic()
+	defer teardown()
+
+	mux.HandleFunc("/api/v2/incidents/unresolved.json", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"page": {"id": "p"}, "incidents": [{"id": "i", "incident_updates": [{"id": "u", "body": "b"}]}]}`)
+	})
+
+	incidents, _, err := client.ListUnresolvedIncidents(context.Background())
+	if err != nil {
+		t.Errorf("PublicClient.ListUnresolvedIncidents returned error: %v", err)
+	}
+
+	want := &[]Incident{{ID: String("i"), IncidentUpdates: []IncidentUpdate{{ID: String("u"), Body: String("b")}}}}
+	if !reflect.DeepEqual(incidents, want) {
+		t.Errorf("PublicClient.ListUnresolvedIncidents returned %+v, want %+v", incidents, want)
+	}
+}
+
+func TestPublicClient_ListUpcomingMaintenances(t *testing.T) {
+	client, mux, teardown := setupPublic()
+	defer teardown()
+
+	mux.HandleFunc("/api/v2/scheduled-maintenances/upcoming.json", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"page": {"id": "p"}, "scheduled_maintenances": [{"id": "m", "status": "scheduled"}]}`)
+	})
+
+	maintenances, _, err := client.ListUpcomingMaintenances(context.Background())
+	if err != nil {
+		t.Errorf("PublicClient.ListUpcomingMaintenances returned error: %v", err)
+	}
+
+	want := &[]Incident{{ID: String("m"), Status: String("scheduled")}}
+	if !reflect.DeepEqual(maintenances, want) {
+		t.Errorf("PublicClient.ListUpcomingMaintenances returned %+v, want %+v", maintenances, want)
+	}
+}
+
+func TestPublicClient_notFound(t *testing.T) {
+	client, mux, teardown := setupPublic()
+	defer teardown()
+
+	mux.HandleFunc("/api/v2/summary.json", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "Not Found", http.StatusNotFound)
+	})
+
+	if _, _, err := client.GetSummary(context.Background()); !IsNotFound(err) {
+		t.Errorf("PublicClient.GetSummary returned %v, want not found error", err)
+	}
+}
@@ -0,0 +1,132 @@
+package statuspage
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// DefaultRateLimit is the documented Statuspage API rate limit of one request
+// per second per API token.
+const DefaultRateLimit = 1.0
+
+// A RateLimiter paces outgoing requests. Every call made through a Client
+// waits on its RateLimiter, if one is set, before the request is sent.
+type RateLimiter interface {
+	// Wait blocks until a request may be sent or ctx is done, in which case
+	// the context's error is returned.
+	Wait(ctx context.Context) error
+}
+
+// TokenBucket is a RateLimiter allowing requests at a steady rate with
+// occasional bursts. It is safe for concurrent use by multiple goroutines.
+type TokenBucket struct {
+	mu     sync.Mutex
+	rate   float64 // tokens added per second
+	burst  float64 // bucket capacity
+	tokens float64
+	last   time.Time
+	now    func() time.Time
+}
+
+// NewRateLimiter returns a TokenBucket allowing requestsPerSecond requests
+// per second on average and up to burst requests at once. burst is raised to
+// one if it is smaller. A non-positive requestsPerSecond disables limiting.
+func NewRateLimiter(requestsPerSecond float64, burst int) *TokenBucket {
+	if burst < 1 {
+		burst = 1
+	}
+	return &TokenBucket{
+		rate:   requestsPerSecond,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		now:    time.Now,
+	}
+}
+
+// Wait implements the RateLimiter interface. It reserves a token and sleeps
+// until the token becomes available. If ctx's deadline would pass first, Wait
+// returns context.DeadlineExceeded right away without consuming a token.
+func (b *TokenBucket) Wait(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	wait := b.reserve()
+	if wait <= 0 {
+		return nil
+	}
+
+	if deadline, ok := ctx.Deadline(); ok && b.now().Add(wait).After(deadline) {
+		b.cancel()
+		return context.DeadlineExceeded
+	}
+
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		b.cancel()
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// reserve takes a token from the bucket and returns how long the caller has
+// to wait until that token is actually available.
+func (b *TokenBucket) reserve() time.Duration {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.rate <= 0 {
+		return 0
+	}
+
+	now := b.now()
+	if !b.last.IsZero() {
+		b.tokens += now.Sub(b.last).Seconds() * b.rate
+		if b.tokens > b.burst {
+			b.tokens = b.burst
+		}
+	}
+	b.last = now
+
+	b.tokens--
+	if b.tokens >= 0 {
+		return 0
+	}
+
+	return time.Duration(-b.tokens / b.rate * float64(time.Second))
+}
+
+// cancel returns a reserved token that was not used.
+func (b *TokenBucket) cancel() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.tokens++
+}
+
+var (
+	sharedLimitersMu sync.Mutex
+	sharedLimiters   = map[string]*TokenBucket{}
+)
+
+// SharedRateLimiter returns the process-wide RateLimiter for the given API
+// token, pacing requests at DefaultRateLimit. Clients created with the same
+// token and this limiter share a single budget, regardless of how many
+// goroutines and services use them.
+func SharedRateLimiter(token string) RateLimiter {
+	sharedLimitersMu.Lock()
+	defer sharedLimitersMu.Unlock()
+
+	limiter, ok := sharedLimiters[token]
+	if !ok {
+		limiter = NewRateLimiter(DefaultRateLimit, 1)
+		sharedLimiters[token] = limiter
+	}
+
+	return limiter
+}
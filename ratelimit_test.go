@@ -0,0 +1,134 @@
+package statuspage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+)
+
+type countingLimiter struct {
+	mu    sync.Mutex
+	calls int
+	err   error
+}
+
+func (l *countingLimiter) Wait(ctx context.Context) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.calls++
+	return l.err
+}
+
+func TestTokenBucket_Wait(t *testing.T) {
+	b := NewRateLimiter(20, 2)
+
+	start := time.Now()
+	for i := 0; i < 4; i++ {
+		if err := b.Wait(context.Background()); err != nil {
+			t.Fatalf("Wait returned error: %v", err)
+		}
+	}
+
+	// Two requests fit into the burst, the other two have to wait 50ms each.
+	if elapsed := time.Since(start); elapsed < 90*time.Millisecond {
+		t.Errorf("Expected Wait to pace requests, took %v", elapsed)
+	}
+}
+
+func TestTokenBucket_WaitDeadline(t *testing.T) {
+	b := NewRateLimiter(0.1, 1)
+	if err := b.Wait(context.Background()); err != nil {
+		t.Fatalf("Wait returned error: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	if err := b.Wait(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Wait returned %v, want %v", err, context.DeadlineExceeded)
+	}
+	if elapsed := time.Since(start); elapsed > 50*time.Millisecond {
+		t.Errorf("Expected Wait to return right away, took %v", elapsed)
+	}
+}
+
+func TestTokenBucket_WaitCanceled(t *testing.T) {
+	b := NewRateLimiter(1, 1)
+	now := time.Now()
+	b.now = func() time.Time { return now }
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if err := b.Wait(ctx); !errors.Is(err, context.Canceled) {
+		t.Errorf("Wait returned %v, want %v", err, context.Canceled)
+	}
+
+	// The canceled call must not have consumed the only token.
+	if err := b.Wait(context.Background()); err != nil {
+		t.Errorf("Wait returned error: %v", err)
+	}
+}
+
+func TestSharedRateLimiter(t *testing.T) {
+	if SharedRateLimiter("a") != SharedRateLimiter("a") {
+		t.Errorf("Expected the same limiter for the same token")
+	}
+	if SharedRateLimiter("a") == SharedRateLimiter("b") {
+		t.Errorf("Expected different limiters for different tokens")
+	}
+}
+
+func TestClient_rateLimiter(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	limiter := &countingLimiter{}
+	client.RateLimiter = limiter
+
+	mux.HandleFunc("/v1/pages/1", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id":"1"}`)
+	})
+	mux.HandleFunc("/v1/pages/1/components", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[]`)
+	})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			client.Page.GetPage(context.Background(), "1")
+		}()
+		go func() {
+			defer wg.Done()
+			client.Component.ListComponents(context.Background(), "1", nil)
+		}()
+	}
+	wg.Wait()
+
+	if limiter.calls != 10 {
+		t.Errorf("Expected limiter to be called 10 times, got %d", limiter.calls)
+	}
+}
+
+func TestClient_rateLimiterError(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	client.RateLimiter = &countingLimiter{err: context.Canceled}
+
+	mux.HandleFunc("/v1/pages/1", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("Request should not have been sent")
+	})
+
+	_, _, err := client.Page.GetPage(context.Background(), "1")
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("PageService.GetPage returned %v, want %v", err, context.Canceled)
+	}
+}
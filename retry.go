@@ -0,0 +1,140 @@
+package statuspage
+
+import (
+	"context"
+	"math"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy configures how the client retries requests that failed with a
+// transient error. Requests rejected with 429 Too Many Requests are retried
+// regardless of their method, since Statuspage did not process them. Requests
+// failing with 502, 503 or 504 are only retried for idempotent methods.
+//
+// Retries never outlive the request context: if the next attempt would start
+// after the context's deadline, the last error is returned right away.
+type RetryPolicy struct {
+	// MaxRetries is the maximum number of retries after the initial attempt.
+	MaxRetries int
+
+	// MinBackoff is the delay before the first retry. It doubles with every
+	// subsequent attempt. If it is zero, retries are not delayed unless
+	// Statuspage sends a Retry-After header.
+	MinBackoff time.Duration
+
+	// MaxBackoff caps the delay between two attempts. A Retry-After header
+	// sent by Statuspage replaces the computed delay but is capped as well,
+	// so that a long Retry-After cannot stall the caller; the retry may then
+	// be rejected again.
+	MaxBackoff time.Duration
+}
+
+// DefaultRetryPolicy returns a RetryPolicy suitable for Statuspage's rate
+// limit of roughly one request per second per token.
+func DefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxRetries: 4,
+		MinBackoff: 1 * time.Second,
+		MaxBackoff: 30 * time.Second,
+	}
+}
+
+// retryable reports whether a request with the given method that received
+// the given status code may be retried.
+func retryable(method string, statusCode int) bool {
+	switch statusCode {
+	case http.StatusTooManyRequests:
+		return true
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return idempotent(method)
+	}
+	return false
+}
+
+func idempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// backoff returns how long to wait before the given retry attempt, starting
+// at 0. If resp carries a Retry-After header, its value is used instead,
+// capped at MaxBackoff.
+func (p *RetryPolicy) backoff(attempt int, resp *http.Response) time.Duration {
+	if resp != nil {
+		if wait, ok := parseRetryAfter(resp.Header.Get("Retry-After")); ok {
+			if p.MaxBackoff > 0 && wait > p.MaxBackoff {
+				wait = p.MaxBackoff
+			}
+			return wait
+		}
+	}
+	if p.MinBackoff <= 0 {
+		return 0
+	}
+
+	limit := p.MaxBackoff
+	if limit <= 0 {
+		limit = math.MaxInt64
+	}
+	// Double by hand rather than shifting, which overflows for large
+	// attempts.
+	wait := p.MinBackoff
+	for i := 0; i < attempt && wait < limit; i++ {
+		if wait > limit/2 {
+			wait = limit
+			break
+		}
+		wait *= 2
+	}
+	wait = min(wait, limit)
+
+	// Apply jitter in [wait/2, wait) to spread out clients sharing a token.
+	half := int64(wait / 2)
+	return time.Duration(half + rand.Int64N(int64(wait)-half))
+}
+
+// parseRetryAfter parses a Retry-After header given either in seconds or as
+// an HTTP date.
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			seconds = 0
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+	if t, err := http.ParseTime(value); err == nil {
+		wait := time.Until(t)
+		if wait < 0 {
+			wait = 0
+		}
+		return wait, true
+	}
+	return 0, false
+}
+
+// sleepCtx waits for d or until ctx is done, whichever happens first. It
+// returns false without waiting if ctx's deadline would pass before d.
+func sleepCtx(ctx context.Context, d time.Duration) bool {
+	if deadline, ok := ctx.Deadline(); ok && time.Now().Add(d).After(deadline) {
+		return false
+	}
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
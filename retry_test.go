@@ -0,0 +1,206 @@
+package statuspage
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func testRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{MaxRetries: 3, MinBackoff: time.Millisecond, MaxBackoff: 5 * time.Millisecond}
+}
+
+func TestClient_retryServerErrors(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+	client.RetryPolicy = testRetryPolicy()
+
+	var calls int
+	mux.HandleFunc("/v1/pages/1", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		fmt.Fprint(w, `{"id":"1"}`)
+	})
+
+	page, _, err := client.Page.GetPage(context.Background(), "1")
+	if err != nil {
+		t.Fatalf("PageService.GetPage returned error: %v", err)
+	}
+	if *page.ID != "1" {
+		t.Errorf("PageService.GetPage returned %+v", page)
+	}
+	if calls != 3 {
+		t.Errorf("Expected 3 attempts, got %d", calls)
+	}
+}
+
+func TestClient_retryExhausted(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+	client.RetryPolicy = testRetryPolicy()
+
+	var calls int
+	mux.HandleFunc("/v1/pages/1", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusBadGateway)
+	})
+
+	_, resp, err := client.Page.GetPage(context.Background(), "1")
+	if !hasStatus(err, http.StatusBadGateway) {
+		t.Errorf("Expected bad gateway error, got %v", err)
+	}
+	if resp == nil || resp.StatusCode != http.StatusBadGateway {
+		t.Errorf("Expected last response to be returned, got %v", resp)
+	}
+	if calls != 4 {
+		t.Errorf("Expected 4 attempts, got %d", calls)
+	}
+}
+
+func TestClient_retryRateLimitedWithBody(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+	client.RetryPolicy = testRetryPolicy()
+
+	var calls int
+	mux.HandleFunc("/v1/pages/1/incidents", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+
+		v := &CreateIncidentRequestBody{}
+		json.NewDecoder(r.Body).Decode(v)
+		if v.Incident.Name != "a" {
+			t.Errorf("Attempt %d sent incident %+v", calls, v.Incident)
+		}
+
+		if calls == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		fmt.Fprint(w, `{"id":"2"}`)
+	})
+
+	_, _, err := client.Incident.CreateIncident(context.Background(), "1", CreateIncidentParams{Name: "a"})
+	if err != nil {
+		t.Fatalf("IncidentService.CreateIncident returned error: %v", err)
+	}
+	if calls != 2 {
+		t.Errorf("Expected 2 attempts, got %d", calls)
+	}
+}
+
+func TestClient_noRetryForNonIdempotentServerErrors(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+	client.RetryPolicy = testRetryPolicy()
+
+	var calls int
+	mux.HandleFunc("/v1/pages/1/incidents", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+
+	_, _, err := client.Incident.CreateIncident(context.Background(), "1", CreateIncidentParams{Name: "a"})
+	if err == nil {
+		t.Fatal("Expected error to be returned")
+	}
+	if calls != 1 {
+		t.Errorf("Expected 1 attempt, got %d", calls)
+	}
+}
+
+func TestClient_noRetryWithoutPolicy(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	var calls int
+	mux.HandleFunc("/v1/pages/1", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusTooManyRequests)
+	})
+
+	_, _, err := client.Page.GetPage(context.Background(), "1")
+	if !IsRateLimited(err) {
+		t.Errorf("Expected rate limited error, got %v", err)
+	}
+	if calls != 1 {
+		t.Errorf("Expected 1 attempt, got %d", calls)
+	}
+}
+
+func TestClient_retryRespectsDeadline(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+	client.RetryPolicy = testRetryPolicy()
+	client.RetryPolicy.MaxBackoff = time.Minute
+
+	var calls int
+	mux.HandleFunc("/v1/pages/1", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Header().Set("Retry-After", "60")
+		w.WriteHeader(http.StatusTooManyRequests)
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	start := time.Now()
+	_, _, err := client.Page.GetPage(ctx, "1")
+	if !IsRateLimited(err) {
+		t.Errorf("Expected rate limited error, got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 500*time.Millisecond {
+		t.Errorf("Expected request to return without waiting, took %v", elapsed)
+	}
+	if calls != 1 {
+		t.Errorf("Expected 1 attempt, got %d", calls)
+	}
+}
+
+func TestRetryPolicy_backoff(t *testing.T) {
+	p := &RetryPolicy{MinBackoff: 100 * time.Millisecond, MaxBackoff: time.Second}
+
+	for attempt, limit := range []time.Duration{100, 200, 400, 800, 1000, 1000} {
+		limit *= time.Millisecond
+		got := p.backoff(attempt, nil)
+		if got < limit/2 || got >= limit {
+			t.Errorf("backoff(%d) = %v, want in [%v, %v)", attempt, got, limit/2, limit)
+		}
+	}
+
+	// Without MaxBackoff, doubling must not overflow into a zero or negative
+	// delay.
+	unbounded := &RetryPolicy{MinBackoff: time.Second}
+	for _, attempt := range []int{40, 64, 1000} {
+		if got := unbounded.backoff(attempt, nil); got < math.MaxInt64/2 {
+			t.Errorf("backoff(%d) without MaxBackoff = %v, want at least %v", attempt, got, time.Duration(math.MaxInt64/2))
+		}
+	}
+
+	// Retry-After replaces the computed delay and is capped at MaxBackoff.
+	resp := &http.Response{Header: http.Header{"Retry-After": []string{"3"}}}
+	if got, want := p.backoff(0, resp), time.Second; got != want {
+		t.Errorf("backoff with Retry-After = %v, want %v", got, want)
+	}
+	if got, want := unbounded.backoff(0, resp), 3*time.Second; got != want {
+		t.Errorf("backoff with Retry-After without MaxBackoff = %v, want %v", got, want)
+	}
+
+	// Without MinBackoff, retries are not delayed.
+	immediate := &RetryPolicy{MaxBackoff: time.Second}
+	for _, attempt := range []int{0, 1, 10} {
+		if got := immediate.backoff(attempt, nil); got != 0 {
+			t.Errorf("backoff(%d) without MinBackoff = %v, want 0", attempt, got)
+		}
+	}
+	if got, want := immediate.backoff(0, resp), time.Second; got != want {
+		t.Errorf("backoff with Retry-After without MinBackoff = %v, want %v", got, want)
+	}
+}
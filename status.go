@@ -0,0 +1,115 @@
+package statuspage
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// ComponentStatus is the status of a component.
+type ComponentStatus string
+
+// Component statuses accepted by the Statuspage API.
+const (
+	ComponentStatusOperational         ComponentStatus = "operational"
+	ComponentStatusUnderMaintenance    ComponentStatus = "under_maintenance"
+	ComponentStatusDegradedPerformance ComponentStatus = "degraded_performance"
+	ComponentStatusPartialOutage       ComponentStatus = "partial_outage"
+	ComponentStatusMajorOutage         ComponentStatus = "major_outage"
+)
+
+// Valid reports whether s is a component status known to the Statuspage API.
+func (s ComponentStatus) Valid() bool {
+	switch s {
+	case ComponentStatusOperational,
+		ComponentStatusUnderMaintenance,
+		ComponentStatusDegradedPerformance,
+		ComponentStatusPartialOutage,
+		ComponentStatusMajorOutage:
+		return true
+	}
+	return false
+}
+
+// MarshalJSON implements the json.Marshaler interface. It returns an error
+// for unknown statuses so that typos are caught before a request is sent.
+func (s ComponentStatus) MarshalJSON() ([]byte, error) {
+	if !s.Valid() {
+		return nil, fmt.Errorf("invalid component status %q", string(s))
+	}
+	return json.Marshal(string(s))
+}
+
+// IncidentStatus is the status of an incident or scheduled maintenance.
+type IncidentStatus string
+
+// Incident statuses accepted by the Statuspage API. The first four apply to
+// realtime incidents, the others to scheduled maintenances.
+const (
+	IncidentStatusInvestigating IncidentStatus = "investigating"
+	IncidentStatusIdentified    IncidentStatus = "identified"
+	IncidentStatusMonitoring    IncidentStatus = "monitoring"
+	IncidentStatusResolved      IncidentStatus = "resolved"
+	IncidentStatusScheduled     IncidentStatus = "scheduled"
+	IncidentStatusInProgress    IncidentStatus = "in_progress"
+	IncidentStatusVerifying     IncidentStatus = "verifying"
+	IncidentStatusCompleted     IncidentStatus = "completed"
+)
+
+// Valid reports whether s is an incident status known to the Statuspage API.
+func (s IncidentStatus) Valid() bool {
+	switch s {
+	case IncidentStatusInvestigating,
+		IncidentStatusIdentified,
+		IncidentStatusMonitoring,
+		IncidentStatusResolved,
+		IncidentStatusScheduled,
+		IncidentStatusInProgress,
+		IncidentStatusVerifying,
+		IncidentStatusCompleted:
+		return true
+	}
+	return false
+}
+
+// MarshalJSON implements the json.Marshaler interface. It returns an error
+// for unknown statuses so that typos are caught before a request is sent.
+func (s IncidentStatus) MarshalJSON() ([]byte, error) {
+	if !s.Valid() {
+		return nil, fmt.Errorf("invalid incident status %q", string(s))
+	}
+	return json.Marshal(string(s))
+}
+
+// IncidentImpact is the impact of an incident.
+type IncidentImpact string
+
+// Incident impacts accepted by the Statuspage API.
+const (
+	IncidentImpactNone        IncidentImpact = "none"
+	IncidentImpactMaintenance IncidentImpact = "maintenance"
+	IncidentImpactMinor       IncidentImpact = "minor"
+	IncidentImpactMajor       IncidentImpact = "major"
+	IncidentImpactCritical    IncidentImpact = "critical"
+)
+
+// Valid reports whether i is an incident impact known to the Statuspage API.
+func (i IncidentImpact) Valid() bool {
+	switch i {
+	case IncidentImpactNone,
+		IncidentImpactMaintenance,
+		IncidentImpactMinor,
+		IncidentImpactMajor,
+		IncidentImpactCritical:
+		return true
+	}
+	return false
+}
+
+// MarshalJSON implements the json.Marshaler interface. It returns an error
+// for unknown impacts so that typos are caught before a request is sent.
+func (i IncidentImpact) MarshalJSON() ([]byte, error) {
+	if !i.Valid() {
+		return nil, fmt.Errorf("invalid incident impact %q", string(i))
+	}
+	return json.Marshal(string(i))
+}
@@ -0,0 +1,117 @@
+package statuspage
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestComponentStatus_Valid(t *testing.T) {
+	for _, s := range []ComponentStatus{
+		ComponentStatusOperational,
+		ComponentStatusUnderMaintenance,
+		ComponentStatusDegradedPerformance,
+		ComponentStatusPartialOutage,
+		ComponentStatusMajorOutage,
+	} {
+		if !s.Valid() {
+			t.Errorf("ComponentStatus(%q).Valid() = false, want true", s)
+		}
+	}
+
+	for _, s := range []ComponentStatus{"", "degraded", "Operational"} {
+		if s.Valid() {
+			t.Errorf("ComponentStatus(%q).Valid() = true, want false", s)
+		}
+	}
+}
+
+func TestIncidentStatus_Valid(t *testing.T) {
+	for _, s := range []IncidentStatus{
+		IncidentStatusInvestigating,
+		IncidentStatusIdentified,
+		IncidentStatusMonitoring,
+		IncidentStatusResolved,
+		IncidentStatusScheduled,
+		IncidentStatusInProgress,
+		IncidentStatusVerifying,
+		IncidentStatusCompleted,
+	} {
+		if !s.Valid() {
+			t.Errorf("IncidentStatus(%q).Valid() = false, want true", s)
+		}
+	}
+
+	if IncidentStatus("closed").Valid() {
+		t.Errorf("IncidentStatus(%q).Valid() = true, want false", "closed")
+	}
+}
+
+func TestIncidentImpact_Valid(t *testing.T) {
+	for _, i := range []IncidentImpact{
+		IncidentImpactNone,
+		IncidentImpactMaintenance,
+		IncidentImpactMinor,
+		IncidentImpactMajor,
+		IncidentImpactCritical,
+	} {
+		if !i.Valid() {
+			t.Errorf("IncidentImpact(%q).Valid() = false, want true", i)
+		}
+	}
+
+	if IncidentImpact("severe").Valid() {
+		t.Errorf("IncidentImpact(%q).Valid() = true, want false", "severe")
+	}
+}
+
+func TestStatus_marshal(t *testing.T) {
+	params := &CreateIncidentParams{
+		Status:         IncidentStatusIdentified,
+		ImpactOverride: IncidentImpactMinor,
+		Components:     map[string]ComponentStatus{"a": ComponentStatusPartialOutage},
+	}
+	testJSONMarshal(t, params, `{
+		"status": "identified",
+		"impact_override": "minor",
+		"components": {"a": "partial_outage"}
+	}`)
+
+	for _, v := range []interface{}{
+		UpdateComponentParams{Status: "degraded"},
+		CreateIncidentParams{Status: "closed"},
+		CreateIncidentParams{ImpactOverride: "severe"},
+		CreateIncidentParams{Components: map[string]ComponentStatus{"a": "down"}},
+	} {
+		if _, err := json.Marshal(v); err == nil {
+			t.Errorf("Expected json.Marshal(%+v) to fail", v)
+		}
+	}
+}
+
+func TestStatus_unmarshalUnknown(t *testing.T) {
+	var params UpdateIncidentParams
+	err := json.Unmarshal([]byte(`{"status":"new_status","impact_override":"new_impact"}`), &params)
+	if err != nil {
+		t.Fatalf("json.Unmarshal returned error: %v", err)
+	}
+	if params.Status != "new_status" || params.ImpactOverride != "new_impact" {
+		t.Errorf("json.Unmarshal returned %+v", params)
+	}
+}
+
+func TestComponentService_UpdateComponent_invalidStatus(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v1/pages/1/components/2", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("Request with invalid status should not have been sent")
+	})
+
+	_, _, err := client.Component.UpdateComponent(context.Background(), "1", "2", UpdateComponentParams{Status: "degraded"})
+	if err == nil || !strings.Contains(err.Error(), `invalid component status "degraded"`) {
+		t.Errorf("ComponentService.UpdateComponent returned %v, want invalid status error", err)
+	}
+}
@@ -7,16 +7,27 @@ import (
 	"fmt"
 	"io"
 	"io/ioutil"
+	"log/slog"
 	"net/http"
 	"net/url"
+	"strconv"
+	"time"
 )
 
 const version = "1.0.0"
 const hostURL = "api.statuspage.io"
 
+const (
+	headerRateLimit     = "X-RateLimit-Limit"
+	headerRateRemaining = "X-RateLimit-Remaining"
+	headerRateReset     = "X-RateLimit-Reset"
+	headerRequestID     = "X-Request-Id"
+)
+
 // A Client manages communication with the Statuspage API.
 type Client struct {
 	httpClient *http.Client
+	timeout    time.Duration // set by WithTimeout, applied to httpClient by NewClient
 
 	// Base URL for API requests. Defaults to the public Statuspage API.
 	BaseURL   *url.URL
@@ -24,24 +35,105 @@ type Client struct {
 	Token     string
 	Version   string
 
+	// RetryPolicy controls retries of requests failing with 429 or 5xx
+	// responses. Requests are not retried if it is nil.
+	RetryPolicy *RetryPolicy
+
+	// RateLimiter paces all requests made through this client, including
+	// retries. Requests are sent right away if it is nil.
+	RateLimiter RateLimiter
+
+	// Logger receives a debug record for every request attempt and an info
+	// record for every retry. Nothing is logged if it is nil.
+	Logger *slog.Logger
+
 	common service // Reuse a single struct instead of allocating one for each service on the heap.
 
 	// Services used for talking to different parts of the Statuspage API.
-	Page      *PageService
-	Component *ComponentService
+	// NewClient sets them to the services of this client. Tests may replace
+	// them with mocks, e.g. from the statuspagemock package.
+	Page            PageAPI
+	Component       ComponentAPI
+	ComponentGroup  ComponentGroupAPI
+	Incident        IncidentAPI
+	Metric          MetricAPI
+	Organization    OrganizationAPI
+	PageAccessUser  PageAccessUserAPI
+	PageAccessGroup PageAccessGroupAPI
+	Subscriber      SubscriberAPI
+	Template        TemplateAPI
 }
 
 type service struct {
 	client *Client
 }
 
+// Response is a Statuspage API response. This wraps the standard http.Response
+// returned from Statuspage and provides convenient access to things like
+// rate limit counters and the request ID.
+type Response struct {
+	*http.Response
+
+	// Rate holds the rate limit counters reported with this response.
+	Rate Rate
+
+	// RequestID identifies the request on the Statuspage side and is useful
+	// when contacting support.
+	RequestID string
+}
+
+// Rate represents the rate limit for the current API token.
+type Rate struct {
+	// The number of requests per window the token is allowed to make.
+	Limit int `json:"limit"`
+
+	// The number of requests remaining in the current rate limit window.
+	Remaining int `json:"remaining"`
+
+	// The time at which the current rate limit window resets.
+	Reset Timestamp `json:"reset"`
+}
+
+func (r Rate) String() string {
+	return Stringify(r)
+}
+
+// newResponse creates a new Response for the provided http.Response.
+// r must not be nil.
+func newResponse(r *http.Response) *Response {
+	response := &Response{Response: r}
+	response.Rate = parseRate(r)
+	response.RequestID = r.Header.Get(headerRequestID)
+	return response
+}
+
+// parseRate parses the rate related headers.
+func parseRate(r *http.Response) Rate {
+	var rate Rate
+	if limit := r.Header.Get(headerRateLimit); limit != "" {
+		rate.Limit, _ = strconv.Atoi(limit)
+	}
+	if remaining := r.Header.Get(headerRateRemaining); remaining != "" {
+		rate.Remaining, _ = strconv.Atoi(remaining)
+	}
+	if reset := r.Header.Get(headerRateReset); reset != "" {
+		if v, _ := strconv.ParseInt(reset, 10, 64); v != 0 {
+			rate.Reset = Timestamp{time.Unix(v, 0)}
+		}
+	}
+	return rate
+}
+
 func (c *Client) newRequest(method, path string, body interface{}) (*http.Request, error) {
-	rel := &url.URL{Path: path}
+	rel, err := url.Parse(path)
+	if err != nil {
+		return nil, err
+	}
 	u := c.BaseURL.ResolveReference(rel)
 	var buf io.ReadWriter
 	if body != nil {
 		buf = new(bytes.Buffer)
-		err := json.NewEncoder(buf).Encode(body)
+		err = json.NewEncoder(buf).Encode(body)
 		if err != nil {
 			return nil, err
 		}
@@ -54,15 +146,64 @@ func (c *Client) newRequest(method, path string, body interface{}) (*http.Reques
 		req.Header.Set("Content-Type", "application/json")
 	}
 	req.Header.Set("Accept", "application/json")
-	req.Header.Set("Authorization", "OAuth "+c.Token)
+	if c.Token != "" {
+		req.Header.Set("Authorization", "OAuth "+c.Token)
+	}
+	if c.UserAgent != "" {
+		req.Header.Set("User-Agent", c.UserAgent)
+	}
 
 	return req, nil
 }
 
-func (c *Client) do(ctx context.Context, req *http.Request, v interface{}) (*http.Response, error) {
-	req.WithContext(ctx)
+// do sends an API request and returns the API response. The API response is
+// JSON decoded and stored in the value pointed to by v, or returned as an
+// error if an API error has occurred. Transient failures are retried
+// according to the client's RetryPolicy, and every attempt waits on the
+// client's RateLimiter.
+func (c *Client) do(ctx context.Context, req *http.Request, v interface{}) (*Response, error) {
+	req = req.WithContext(ctx)
 
+	for attempt := 0; ; attempt++ {
+		if c.RateLimiter != nil {
+			if err := c.RateLimiter.Wait(ctx); err != nil {
+				return nil, err
+			}
+		}
+
+		response, err := c.doOnce(ctx, req, v)
+
+		policy := c.RetryPolicy
+		if policy == nil || attempt >= policy.MaxRetries || response == nil ||
+			!retryable(req.Method, response.StatusCode) {
+			return response, err
+		}
+
+		wait := policy.backoff(attempt, response.Response)
+		if !sleepCtx(ctx, wait) {
+			return response, err
+		}
+		c.logInfo(ctx, "retrying request", "method", req.Method, "url", req.URL.String(),
+			"status", response.StatusCode, "attempt", attempt+1, "wait", wait)
+
+		if req.GetBody != nil {
+			body, bodyErr := req.GetBody()
+			if bodyErr != nil {
+				return response, err
+			}
+			req.Body = body
+		}
+	}
+}
+
+// doOnce performs a single attempt of an API request.
+func (c *Client) doOnce(ctx context.Context, req *http.Request, v interface{}) (*Response, error) {
+	start := time.Now()
 	resp, err := c.httpClient.Do(req)
+	if err == nil {
+		c.logDebug(ctx, "request completed", "method", req.Method, "url", req.URL.String(),
+			"status", resp.StatusCode, "duration", time.Since(start))
+	}
 	if err != nil {
 		// If we got an error, and the context has been canceled,
 		// the context's error is probably more useful.
@@ -84,30 +225,45 @@ func (c *Client) do(ctx context.Context, req *http.Request, v interface{}) (*htt
 	}
 	defer resp.Body.Close()
 
+	response := newResponse(resp)
+
 	if resp.StatusCode < 400 {
-		if v == nil {
-			return resp, nil
+		// A 304 Not Modified answer to a conditional request has no body.
+		if v == nil || resp.StatusCode == http.StatusNotModified {
+			return response, nil
 		}
 
 		err = json.NewDecoder(resp.Body).Decode(v)
-		return resp, err
+		return response, err
 	}
 
 	body, err := ioutil.ReadAll(resp.Body)
 	if err != nil {
-		return nil, fmt.Errorf("error reading response body: %s", err)
+		return response, fmt.Errorf("error reading response body: %s", err)
 	}
 
-	return nil, fmt.Errorf("response %s: %d – %s", resp.Status, resp.StatusCode, string(body))
+	return response, checkResponse(resp, body)
+}
+
+func (c *Client) logDebug(ctx context.Context, msg string, args ...interface{}) {
+	if c.Logger != nil {
+		c.Logger.DebugContext(ctx, msg, args...)
+	}
 }
 
-// NewClient returns a new Statuspage API client. If a nil httpClient is
-// provided, http.DefaultClient will be used.
-func NewClient(token string, httpClient *http.Client) *Client {
-	if httpClient == nil {
-		httpClient = http.DefaultClient
+func (c *Client) logInfo(ctx context.Context, msg string, args ...interface{}) {
+	if c.Logger != nil {
+		c.Logger.InfoContext(ctx, msg, args...)
 	}
+}
 
+// NewClient returns a new Statuspage API client authenticating with the
+// given API token. Without options it talks to the public Statuspage API
+// using http.DefaultClient, without retries or rate limiting.
+//
+// For compatibility with the previous NewClient(token, httpClient) signature,
+// nil options are ignored.
+func NewClient(token string, opts ...Option) *Client {
 	baseURL := &url.URL{Host: hostURL, Scheme: "https"}
 
 	c := &Client{
@@ -117,9 +273,29 @@ func NewClient(token string, httpClient *http.Client) *Client {
 		Version:    version,
 	}
 
+	for _, opt := range opts {
+		if opt != nil {
+			opt(c)
+		}
+	}
+	if c.timeout > 0 {
+		// Copy the HTTP client so that a shared one is left untouched.
+		httpClient := *c.httpClient
+		httpClient.Timeout = c.timeout
+		c.httpClient = &httpClient
+	}
+
 	c.common.client = c
 	c.Page = (*PageService)(&c.common)
 	c.Component = (*ComponentService)(&c.common)
+	c.ComponentGroup = (*ComponentGroupService)(&c.common)
+	c.Incident = (*IncidentService)(&c.common)
+	c.Metric = (*MetricService)(&c.common)
+	c.Organization = (*OrganizationService)(&c.common)
+	c.PageAccessUser = (*PageAccessUserService)(&c.common)
+	c.PageAccessGroup = (*PageAccessGroupService)(&c.common)
+	c.Subscriber = (*SubscriberService)(&c.common)
+	c.Template = (*TemplateService)(&c.common)
 
 	return c
 }
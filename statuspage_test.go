@@ -2,6 +2,7 @@ package statuspage
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -100,3 +101,50 @@ func Int64(v int64) *int64 { return &v }
 // String is a helper routine that allocates a new string value
 // to store v and returns a pointer to it.
 func String(v string) *string { return &v }
+
+func TestResponse_populateRate(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v1/pages/1", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(headerRateLimit, "60")
+		w.Header().Set(headerRateRemaining, "59")
+		w.Header().Set(headerRateReset, "1136214245")
+		w.Header().Set(headerRequestID, "abc")
+		fmt.Fprint(w, `{"id":"1"}`)
+	})
+
+	_, resp, err := client.Page.GetPage(context.Background(), "1")
+	if err != nil {
+		t.Fatalf("PageService.GetPage returned error: %v", err)
+	}
+
+	want := Rate{Limit: 60, Remaining: 59, Reset: Timestamp{time.Unix(1136214245, 0)}}
+	if !reflect.DeepEqual(resp.Rate, want) {
+		t.Errorf("Response.Rate = %v, want %v", resp.Rate, want)
+	}
+	if resp.RequestID != "abc" {
+		t.Errorf("Response.RequestID = %q, want %q", resp.RequestID, "abc")
+	}
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("Response.StatusCode = %d, want %d", resp.StatusCode, http.StatusOK)
+	}
+}
+
+func TestResponse_onError(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v1/pages/1", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(headerRequestID, "abc")
+		http.Error(w, `{"error":"Not found"}`, http.StatusNotFound)
+	})
+
+	_, resp, err := client.Page.GetPage(context.Background(), "1")
+	if !IsNotFound(err) {
+		t.Fatalf("Expected not found error, got %v", err)
+	}
+	if resp == nil || resp.RequestID != "abc" {
+		t.Errorf("Expected response with request ID to be returned, got %v", resp)
+	}
+}
@@ -0,0 +1,80 @@
+package statuspagemock
+
+import (
+	"context"
+	"iter"
+	"time"
+
+	"github.com/nagelflorian/statuspage-go"
+)
+
+// ComponentAPI is a mock statuspage.ComponentAPI.
+type ComponentAPI struct {
+	Recorder
+
+	GetComponentFunc       func(ctx context.Context, pageID string, componentID string) (*statuspage.Component, *statuspage.Response, error)
+	ListComponentsFunc     func(ctx context.Context, pageID string, opts *statuspage.ListOptions) (*[]statuspage.Component, *statuspage.Response, error)
+	AllFunc                func(ctx context.Context, pageID string) iter.Seq2[statuspage.Component, error]
+	DeleteComponentFunc    func(ctx context.Context, pageID string, componentID string) (*statuspage.Response, error)
+	CreateComponentFunc    func(ctx context.Context, pageID string, component statuspage.CreateComponentParams) (*statuspage.Component, *statuspage.Response, error)
+	UpdateComponentFunc    func(ctx context.Context, pageID string, componentID string, component statuspage.UpdateComponentParams) (*statuspage.Component, *statuspage.Response, error)
+	GetComponentUptimeFunc func(ctx context.Context, pageID string, componentID string, start, end time.Time) (*statuspage.Uptime, *statuspage.Response, error)
+}
+
+var _ statuspage.ComponentAPI = (*ComponentAPI)(nil)
+
+func (m *ComponentAPI) GetComponent(ctx context.Context, pageID string, componentID string) (*statuspage.Component, *statuspage.Response, error) {
+	m.record("GetComponent", pageID, componentID)
+	if m.GetComponentFunc != nil {
+		return m.GetComponentFunc(ctx, pageID, componentID)
+	}
+	return nil, nil, notMocked("ComponentAPI.GetComponent")
+}
+
+func (m *ComponentAPI) ListComponents(ctx context.Context, pageID string, opts *statuspage.ListOptions) (*[]statuspage.Component, *statuspage.Response, error) {
+	m.record("ListComponents", pageID, opts)
+	if m.ListComponentsFunc != nil {
+		return m.ListComponentsFunc(ctx, pageID, opts)
+	}
+	return nil, nil, notMocked("ComponentAPI.ListComponents")
+}
+
+func (m *ComponentAPI) All(ctx context.Context, pageID string) iter.Seq2[statuspage.Component, error] {
+	m.record("All", pageID)
+	if m.AllFunc != nil {
+		return m.AllFunc(ctx, pageID)
+	}
+	return notMockedSeq[statuspage.Component]("ComponentAPI.All")
+}
+
+func (m *ComponentAPI) DeleteComponent(ctx context.Context, pageID string, componentID string) (*statuspage.Response, error) {
+	m.record("DeleteComponent", pageID, componentID)
+	if m.DeleteComponentFunc != nil {
+		return m.DeleteComponentFunc(ctx, pageID, componentID)
+	}
+	return nil, notMocked("ComponentAPI.DeleteComponent")
+}
+
+func (m *ComponentAPI) CreateComponent(ctx context.Context, pageID string, component statuspage.CreateComponentParams) (*statuspage.Component, *statuspage.Response, error) {
+	m.record("CreateComponent", pageID, component)
+	if m.CreateComponentFunc != nil {
+		return m.CreateComponentFunc(ctx, pageID, component)
+	}
+	return nil, nil, notMocked("ComponentAPI.CreateComponent")
+}
+
+func (m *ComponentAPI) UpdateComponent(ctx context.Context, pageID string, componentID string, component statuspage.UpdateComponentParams) (*statuspage.Component, *statuspage.Response, error) {
+	m.record("UpdateComponent", pageID, componentID, component)
+	if m.UpdateComponentFunc != nil {
+		return m.UpdateComponentFunc(ctx, pageID, componentID, component)
+	}
+	return nil, nil, notMocked("ComponentAPI.UpdateComponent")
+}
+
+func (m *ComponentAPI) GetComponentUptime(ctx context.Context, pageID string, componentID string, start, end time.Time) (*statuspage.Uptime, *statuspage.Response, error) {
+	m.record("GetComponentUptime", pageID, componentID, start, end)
+	if m.GetComponentUptimeFunc != nil {
+		return m.GetComponentUptimeFunc(ctx, pageID, componentID, start, end)
+	}
+	return nil, nil, notMocked("ComponentAPI.GetComponentUptime")
+}
@@ -0,0 +1,80 @@
+package statuspagemock
+
+import (
+	"context"
+	"iter"
+	"time"
+
+	"github.com/nagelflorian/statuspage-go"
+)
+
+// ComponentGroupAPI is a mock statuspage.ComponentGroupAPI.
+type ComponentGroupAPI struct {
+	Recorder
+
+	GetComponentGroupFunc       func(ctx context.Context, pageID string, groupID string) (*statuspage.ComponentGroup, *statuspage.Response, error)
+	ListComponentGroupsFunc     func(ctx context.Context, pageID string, opts *statuspage.ListOptions) (*[]statuspage.ComponentGroup, *statuspage.Response, error)
+	AllFunc                     func(ctx context.Context, pageID string) iter.Seq2[statuspage.ComponentGroup, error]
+	CreateComponentGroupFunc    func(ctx context.Context, pageID string, group statuspage.CreateComponentGroupParams) (*statuspage.ComponentGroup, *statuspage.Response, error)
+	UpdateComponentGroupFunc    func(ctx context.Context, pageID string, groupID string, group statuspage.UpdateComponentGroupParams) (*statuspage.ComponentGroup, *statuspage.Response, error)
+	DeleteComponentGroupFunc    func(ctx context.Context, pageID string, groupID string) (*statuspage.Response, error)
+	GetComponentGroupUptimeFunc func(ctx context.Context, pageID string, groupID string, start, end time.Time) (*statuspage.Uptime, *statuspage.Response, error)
+}
+
+var _ statuspage.ComponentGroupAPI = (*ComponentGroupAPI)(nil)
+
+func (m *ComponentGroupAPI) GetComponentGroup(ctx context.Context, pageID string, groupID string) (*statuspage.ComponentGroup, *statuspage.Response, error) {
+	m.record("GetComponentGroup", pageID, groupID)
+	if m.GetComponentGroupFunc != nil {
+		return m.GetComponentGroupFunc(ctx, pageID, groupID)
+	}
+	return nil, nil, notMocked("ComponentGroupAPI.GetComponentGroup")
+}
+
+func (m *ComponentGroupAPI) ListComponentGroups(ctx context.Context, pageID string, opts *statuspage.ListOptions) (*[]statuspage.ComponentGroup, *statuspage.Response, error) {
+	m.record("ListComponentGroups", pageID, opts)
+	if m.ListComponentGroupsFunc != nil {
+		return m.ListComponentGroupsFunc(ctx, pageID, opts)
+	}
+	return nil, nil, notMocked("ComponentGroupAPI.ListComponentGroups")
+}
+
+func (m *ComponentGroupAPI) All(ctx context.Context, pageID string) iter.Seq2[statuspage.ComponentGroup, error] {
+	m.record("All", pageID)
+	if m.AllFunc != nil {
+		return m.AllFunc(ctx, pageID)
+	}
+	return notMockedSeq[statuspage.ComponentGroup]("ComponentGroupAPI.All")
+}
+
+func (m *ComponentGroupAPI) CreateComponentGroup(ctx context.Context, pageID string, group statuspage.CreateComponentGroupParams) (*statuspage.ComponentGroup, *statuspage.Response, error) {
+	m.record("CreateComponentGroup", pageID, group)
+	if m.CreateComponentGroupFunc != nil {
+		return m.CreateComponentGroupFunc(ctx, pageID, group)
+	}
+	return nil, nil, notMocked("ComponentGroupAPI.CreateComponentGroup")
+}
+
+func (m *ComponentGroupAPI) UpdateComponentGroup(ctx context.Context, pageID string, groupID string, group statuspage.UpdateComponentGroupParams) (*statuspage.ComponentGroup, *statuspage.Response, error) {
+	m.record("UpdateComponentGroup", pageID, groupID, group)
+	if m.UpdateComponentGroupFunc != nil {
+		return m.UpdateComponentGroupFunc(ctx, pageID, groupID, group)
+	}
+	return nil, nil, notMocked("ComponentGroupAPI.UpdateComponentGroup")
+}
+
+func (m *ComponentGroupAPI) DeleteComponentGroup(ctx context.Context, pageID string, groupID string) (*statuspage.Response, error) {
+	m.record("DeleteComponentGroup", pageID, groupID)
+	if m.DeleteComponentGroupFunc != nil {
+		return m.DeleteComponentGroupFunc(ctx, pageID, groupID)
+	}
+	return nil, notMocked("ComponentGroupAPI.DeleteComponentGroup")
+}
+
+func (m *ComponentGroupAPI) GetComponentGroupUptime(ctx context.Context, pageID string, groupID string, start, end time.Time) (*statuspage.Uptime, *statuspage.Response, error) {
+	m.record("GetComponentGroupUptime", pageID, groupID, start, end)
+	if m.GetComponentGroupUptimeFunc != nil {
+		return m.GetComponentGroupUptimeFunc(ctx, pageID, groupID, start, end)
+	}
+	return nil, nil, notMocked("ComponentGroupAPI.GetComponentGroupUptime")
+}
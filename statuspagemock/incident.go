@@ -0,0 +1,178 @@
+package statuspagemock
+
+import (
+	"context"
+	"iter"
+
+	"github.com/nagelflorian/statuspage-go"
+)
+
+// IncidentAPI is a mock statuspage.IncidentAPI.
+type IncidentAPI struct {
+	Recorder
+
+	CreateIncidentFunc                 func(ctx context.Context, pageID string, incident statuspage.CreateIncidentParams) (*statuspage.Incident, *statuspage.Response, error)
+	UpdateIncidentFunc                 func(ctx context.Context, pageID string, incidentID string, incident statuspage.UpdateIncidentParams) (*statuspage.Incident, *statuspage.Response, error)
+	ResolveIncidentFunc                func(ctx context.Context, pageID string, incidentID string, body string) (*statuspage.Incident, *statuspage.Response, error)
+	GetIncidentFunc                    func(ctx context.Context, pageID string, incidentID string) (*statuspage.Incident, *statuspage.Response, error)
+	DeleteIncidentFunc                 func(ctx context.Context, pageID string, incidentID string) (*statuspage.Response, error)
+	ListIncidentsFunc                  func(ctx context.Context, pageID string, opts *statuspage.ListOptions) (*[]statuspage.Incident, *statuspage.Response, error)
+	ListUnresolvedIncidentsFunc        func(ctx context.Context, pageID string, opts *statuspage.ListOptions) (*[]statuspage.Incident, *statuspage.Response, error)
+	ListUpcomingIncidentsFunc          func(ctx context.Context, pageID string, opts *statuspage.ListOptions) (*[]statuspage.Incident, *statuspage.Response, error)
+	ListActiveMaintenanceIncidentsFunc func(ctx context.Context, pageID string, opts *statuspage.ListOptions) (*[]statuspage.Incident, *statuspage.Response, error)
+	ListScheduledIncidentsFunc         func(ctx context.Context, pageID string, opts *statuspage.ListOptions) (*[]statuspage.Incident, *statuspage.Response, error)
+	AllFunc                            func(ctx context.Context, pageID string) iter.Seq2[statuspage.Incident, error]
+	ScheduleMaintenanceFunc            func(ctx context.Context, pageID string, window statuspage.MaintenanceWindow) (*statuspage.Incident, *statuspage.Response, error)
+	CompleteMaintenanceFunc            func(ctx context.Context, pageID string, incidentID string, body string) (*statuspage.Incident, *statuspage.Response, error)
+	GetPostmortemFunc                  func(ctx context.Context, pageID string, incidentID string) (*statuspage.Postmortem, *statuspage.Response, error)
+	CreateOrUpdatePostmortemFunc       func(ctx context.Context, pageID string, incidentID string, bodyDraft string) (*statuspage.Postmortem, *statuspage.Response, error)
+	PublishPostmortemFunc              func(ctx context.Context, pageID string, incidentID string, params statuspage.PublishPostmortemParams) (*statuspage.Postmortem, *statuspage.Response, error)
+	RevertPostmortemFunc               func(ctx context.Context, pageID string, incidentID string) (*statuspage.Postmortem, *statuspage.Response, error)
+	CreateIncidentFromTemplateFunc     func(ctx context.Context, pageID string, templateID string, overrides *statuspage.IncidentTemplateOverrides) (*statuspage.Incident, *statuspage.Response, error)
+}
+
+var _ statuspage.IncidentAPI = (*IncidentAPI)(nil)
+
+func (m *IncidentAPI) CreateIncident(ctx context.Context, pageID string, incident statuspage.CreateIncidentParams) (*statuspage.Incident, *statuspage.Response, error) {
+	m.record("CreateIncident", pageID, incident)
+	if m.CreateIncidentFunc != nil {
+		return m.CreateIncidentFunc(ctx, pageID, incident)
+	}
+	return nil, nil, notMocked("IncidentAPI.CreateIncident")
+}
+
+func (m *IncidentAPI) UpdateIncident(ctx context.Context, pageID string, incidentID string, incident statuspage.UpdateIncidentParams) (*statuspage.Incident, *statuspage.Response, error) {
+	m.record("UpdateIncident", pageID, incidentID, incident)
+	if m.UpdateIncidentFunc != nil {
+		return m.UpdateIncidentFunc(ctx, pageID, incidentID, incident)
+	}
+	return nil, nil, notMocked("IncidentAPI.UpdateIncident")
+}
+
+func (m *IncidentAPI) ResolveIncident(ctx context.Context, pageID string, incidentID string, body string) (*statuspage.Incident, *statuspage.Response, error) {
+	m.record("ResolveIncident", pageID, incidentID, body)
+	if m.ResolveIncidentFunc != nil {
+		return m.ResolveIncidentFunc(ctx, pageID, incidentID, body)
+	}
+	return nil, nil, notMocked("IncidentAPI.ResolveIncident")
+}
+
+func (m *IncidentAPI) GetIncident(ctx context.Context, pageID string, incidentID string) (*statuspage.Incident, *statuspage.Response, error) {
+	m.record("GetIncident", pageID, incidentID)
+	if m.GetIncidentFunc != nil {
+		return m.GetIncidentFunc(ctx, pageID, incidentID)
+	}
+	return nil, nil, notMocked("IncidentAPI.GetIncident")
+}
+
+func (m *IncidentAPI) DeleteIncident(ctx context.Context, pageID string, incidentID string) (*statuspage.Response, error) {
+	m.record("DeleteIncident", pageID, incidentID)
+	if m.DeleteIncidentFunc != nil {
+		return m.DeleteIncidentFunc(ctx, pageID, incidentID)
+	}
+	return nil, notMocked("IncidentAPI.DeleteIncident")
+}
+
+func (m *IncidentAPI) ListIncidents(ctx context.Context, pageID string, opts *statuspage.ListOptions) (*[]statuspage.Incident, *statuspage.Response, error) {
+	m.record("ListIncidents", pageID, opts)
+	if m.ListIncidentsFunc != nil {
+		return m.ListIncidentsFunc(ctx, pageID, opts)
+	}
+	return nil, nil, notMocked("IncidentAPI.ListIncidents")
+}
+
+func (m *IncidentAPI) ListUnresolvedIncidents(ctx context.Context, pageID string, opts *statuspage.ListOptions) (*[]statuspage.Incident, *statuspage.Response, error) {
+	m.record("ListUnresolvedIncidents", pageID, opts)
+	if m.ListUnresolvedIncidentsFunc != nil {
+		return m.ListUnresolvedIncidentsFunc(ctx, pageID, opts)
+	}
+	return nil, nil, notMocked("IncidentAPI.ListUnresolvedIncidents")
+}
+
+func (m *IncidentAPI) ListUpcomingIncidents(ctx context.Context, pageID string, opts *statuspage.ListOptions) (*[]statuspage.Incident, *statuspage.Response, error) {
+	m.record("ListUpcomingIncidents", pageID, opts)
+	if m.ListUpcomingIncidentsFunc != nil {
+		return m.ListUpcomingIncidentsFunc(ctx, pageID, opts)
+	}
+	return nil, nil, notMocked("IncidentAPI.ListUpcomingIncidents")
+}
+
+func (m *IncidentAPI) ListActiveMaintenanceIncidents(ctx context.Context, pageID string, opts *statuspage.ListOptions) (*[]statuspage.Incident, *statuspage.Response, error) {
+	m.record("ListActiveMaintenanceIncidents", pageID, opts)
+	if m.ListActiveMaintenanceIncidentsFunc != nil {
+		return m.ListActiveMaintenanceIncidentsFunc(ctx, pageID, opts)
+	}
+	return nil, nil, notMocked("IncidentAPI.ListActiveMaintenanceIncidents")
+}
+
+func (m *IncidentAPI) ListScheduledIncidents(ctx context.Context, pageID string, opts *statuspage.ListOptions) (*[]statuspage.Incident, *statuspage.Response, error) {
+	m.record("ListScheduledIncidents", pageID, opts)
+	if m.ListScheduledIncidentsFunc != nil {
+		return m.ListScheduledIncidentsFunc(ctx, pageID, opts)
+	}
+	return nil, nil, notMocked("IncidentAPI.ListScheduledIncidents")
+}
+
+func (m *IncidentAPI) All(ctx context.Context, pageID string) iter.Seq2[statuspage.Incident, error] {
+	m.record("All", pageID)
+	if m.AllFunc != nil {
+		return m.AllFunc(ctx, pageID)
+	}
+	return notMockedSeq[statuspage.Incident]("IncidentAPI.All")
+}
+
+func (m *IncidentAPI) ScheduleMaintenance(ctx context.Context, pageID string, window statuspage.MaintenanceWindow) (*statuspage.Incident, *statuspage.Response, error) {
+	m.record("ScheduleMaintenance", pageID, window)
+	if m.ScheduleMaintenanceFunc != nil {
+		return m.ScheduleMaintenanceFunc(ctx, pageID, window)
+	}
+	return nil, nil, notMocked("IncidentAPI.ScheduleMaintenance")
+}
+
+func (m *IncidentAPI) CompleteMaintenance(ctx context.Context, pageID string, incidentID string, body string) (*statuspage.Incident, *statuspage.Response, error) {
+	m.record("CompleteMaintenance", pageID, incidentID, body)
+	if m.CompleteMaintenanceFunc != nil {
+		return m.CompleteMaintenanceFunc(ctx, pageID, incidentID, body)
+	}
+	return nil, nil, notMocked("IncidentAPI.CompleteMaintenance")
+}
+
+func (m *IncidentAPI) GetPostmortem(ctx context.Context, pageID string, incidentID string) (*statuspage.Postmortem, *statuspage.Response, error) {
+	m.record("GetPostmortem", pageID, incidentID)
+	if m.GetPostmortemFunc != nil {
+		return m.GetPostmortemFunc(ctx, pageID, incidentID)
+	}
+	return nil, nil, notMocked("IncidentAPI.GetPostmortem")
+}
+
+func (m *IncidentAPI) CreateOrUpdatePostmortem(ctx context.Context, pageID string, incidentID string, bodyDraft string) (*statuspage.Postmortem, *statuspage.Response, error) {
+	m.record("CreateOrUpdatePostmortem", pageID, incidentID, bodyDraft)
+	if m.CreateOrUpdatePostmortemFunc != nil {
+		return m.CreateOrUpdatePostmortemFunc(ctx, pageID, incidentID, bodyDraft)
+	}
+	return nil, nil, notMocked("IncidentAPI.CreateOrUpdatePostmortem")
+}
+
+func (m *IncidentAPI) PublishPostmortem(ctx context.Context, pageID string, incidentID string, params statuspage.PublishPostmortemParams) (*statuspage.Postmortem, *statuspage.Response, error) {
+	m.record("PublishPostmortem", pageID, incidentID, params)
+	if m.PublishPostmortemFunc != nil {
+		return m.PublishPostmortemFunc(ctx, pageID, incidentID, params)
+	}
+	return nil, nil, notMocked("IncidentAPI.PublishPostmortem")
+}
+
+func (m *IncidentAPI) RevertPostmortem(ctx context.Context, pageID string, incidentID string) (*statuspage.Postmortem, *statuspage.Response, error) {
+	m.record("RevertPostmortem", pageID, incidentID)
+	if m.RevertPostmortemFunc != nil {
+		return m.RevertPostmortemFunc(ctx, pageID, incidentID)
+	}
+	return nil, nil, notMocked("IncidentAPI.RevertPostmortem")
+}
+
+func (m *IncidentAPI) CreateIncidentFromTemplate(ctx context.Context, pageID string, templateID string, overrides *statuspage.IncidentTemplateOverrides) (*statuspage.Incident, *statuspage.Response, error) {
+	m.record("CreateIncidentFromTemplate", pageID, templateID, overrides)
+	if m.CreateIncidentFromTemplateFunc != nil {
+		return m.CreateIncidentFromTemplateFunc(ctx, pageID, templateID, overrides)
+	}
+	return nil, nil, notMocked("IncidentAPI.CreateIncidentFromTemplate")
+}
@@ -0,0 +1,143 @@
+package statuspagemock
+
+import (
+	"context"
+
+	"github.com/nagelflorian/statuspage-go"
+)
+
+// MetricAPI is a mock statuspage.MetricAPI. A statuspage.MetricBatcher
+// built over it with statuspage.NewMetricBatcher submits through
+// SubmitDataPointsFunc.
+type MetricAPI struct {
+	Recorder
+
+	ListMetricsProvidersFunc  func(ctx context.Context, pageID string, opts *statuspage.ListOptions) (*[]statuspage.MetricsProvider, *statuspage.Response, error)
+	GetMetricsProviderFunc    func(ctx context.Context, pageID string, providerID string) (*statuspage.MetricsProvider, *statuspage.Response, error)
+	CreateMetricsProviderFunc func(ctx context.Context, pageID string, provider statuspage.CreateMetricsProviderParams) (*statuspage.MetricsProvider, *statuspage.Response, error)
+	UpdateMetricsProviderFunc func(ctx context.Context, pageID string, providerID string, provider statuspage.UpdateMetricsProviderParams) (*statuspage.MetricsProvider, *statuspage.Response, error)
+	DeleteMetricsProviderFunc func(ctx context.Context, pageID string, providerID string) (*statuspage.Response, error)
+	ListMetricsFunc           func(ctx context.Context, pageID string, opts *statuspage.ListOptions) (*[]statuspage.Metric, *statuspage.Response, error)
+	ListProviderMetricsFunc   func(ctx context.Context, pageID string, providerID string, opts *statuspage.ListOptions) (*[]statuspage.Metric, *statuspage.Response, error)
+	GetMetricFunc             func(ctx context.Context, pageID string, metricID string) (*statuspage.Metric, *statuspage.Response, error)
+	CreateMetricFunc          func(ctx context.Context, pageID string, providerID string, metric statuspage.CreateMetricParams) (*statuspage.Metric, *statuspage.Response, error)
+	UpdateMetricFunc          func(ctx context.Context, pageID string, metricID string, metric statuspage.UpdateMetricParams) (*statuspage.Metric, *statuspage.Response, error)
+	DeleteMetricFunc          func(ctx context.Context, pageID string, metricID string) (*statuspage.Response, error)
+	SubmitDataPointFunc       func(ctx context.Context, pageID string, metricID string, point statuspage.MetricDataPoint) (*statuspage.Response, error)
+	SubmitDataPointsFunc      func(ctx context.Context, pageID string, points map[string][]statuspage.MetricDataPoint) (*statuspage.Response, error)
+	ResetMetricDataFunc       func(ctx context.Context, pageID string, metricID string) (*statuspage.Response, error)
+}
+
+var _ statuspage.MetricAPI = (*MetricAPI)(nil)
+
+func (m *MetricAPI) ListMetricsProviders(ctx context.Context, pageID string, opts *statuspage.ListOptions) (*[]statuspage.MetricsProvider, *statuspage.Response, error) {
+	m.record("ListMetricsProviders", pageID, opts)
+	if m.ListMetricsProvidersFunc != nil {
+		return m.ListMetricsProvidersFunc(ctx, pageID, opts)
+	}
+	return nil, nil, notMocked("MetricAPI.ListMetricsProviders")
+}
+
+func (m *MetricAPI) GetMetricsProvider(ctx context.Context, pageID string, providerID string) (*statuspage.MetricsProvider, *statuspage.Response, error) {
+	m.record("GetMetricsProvider", pageID, providerID)
+	if m.GetMetricsProviderFunc != nil {
+		return m.GetMetricsProviderFunc(ctx, pageID, providerID)
+	}
+	return nil, nil, notMocked("MetricAPI.GetMetricsProvider")
+}
+
+func (m *MetricAPI) CreateMetricsProvider(ctx context.Context, pageID string, provider statuspage.CreateMetricsProviderParams) (*statuspage.MetricsProvider, *statuspage.Response, error) {
+	m.record("CreateMetricsProvider", pageID, provider)
+	if m.CreateMetricsProviderFunc != nil {
+		return m.CreateMetricsProviderFunc(ctx, pageID, provider)
+	}
+	return nil, nil, notMocked("MetricAPI.CreateMetricsProvider")
+}
+
+func (m *MetricAPI) UpdateMetricsProvider(ctx context.Context, pageID string, providerID string, provider statuspage.UpdateMetricsProviderParams) (*statuspage.MetricsProvider, *statuspage.Response, error) {
+	m.record("UpdateMetricsProvider", pageID, providerID, provider)
+	if m.UpdateMetricsProviderFunc != nil {
+		return m.UpdateMetricsProviderFunc(ctx, pageID, providerID, provider)
+	}
+	return nil, nil, notMocked("MetricAPI.UpdateMetricsProvider")
+}
+
+func (m *MetricAPI) DeleteMetricsProvider(ctx context.Context, pageID string, providerID string) (*statuspage.Response, error) {
+	m.record("DeleteMetricsProvider", pageID, providerID)
+	if m.DeleteMetricsProviderFunc != nil {
+		return m.DeleteMetricsProviderFunc(ctx, pageID, providerID)
+	}
+	return nil, notMocked("MetricAPI.DeleteMetricsProvider")
+}
+
+func (m *MetricAPI) ListMetrics(ctx context.Context, pageID string, opts *statuspage.ListOptions) (*[]statuspage.Metric, *statuspage.Response, error) {
+	m.record("ListMetrics", pageID, opts)
+	if m.ListMetricsFunc != nil {
+		return m.ListMetricsFunc(ctx, pageID, opts)
+	}
+	return nil, nil, notMocked("MetricAPI.ListMetrics")
+}
+
+func (m *MetricAPI) ListProviderMetrics(ctx context.Context, pageID string, providerID string, opts *statuspage.ListOptions) (*[]statuspage.Metric, *statuspage.Response, error) {
+	m.record("ListProviderMetrics", pageID, providerID, opts)
+	if m.ListProviderMetricsFunc != nil {
+		return m.ListProviderMetricsFunc(ctx, pageID, providerID, opts)
+	}
+	return nil, nil, notMocked("MetricAPI.ListProviderMetrics")
+}
+
+func (m *MetricAPI) GetMetric(ctx context.Context, pageID string, metricID string) (*statuspage.Metric, *statuspage.Response, error) {
+	m.record("GetMetric", pageID, metricID)
+	if m.GetMetricFunc != nil {
+		return m.GetMetricFunc(ctx, pageID, metricID)
+	}
+	return nil, nil, notMocked("MetricAPI.GetMetric")
+}
+
+func (m *MetricAPI) CreateMetric(ctx context.Context, pageID string, providerID string, metric statuspage.CreateMetricParams) (*statuspage.Metric, *statuspage.Response, error) {
+	m.record("CreateMetric", pageID, providerID, metric)
+	if m.CreateMetricFunc != nil {
+		return m.CreateMetricFunc(ctx, pageID, providerID, metric)
+	}
+	return nil, nil, notMocked("MetricAPI.CreateMetric")
+}
+
+func (m *MetricAPI) UpdateMetric(ctx context.Context, pageID string, metricID string, metric statuspage.UpdateMetricParams) (*statuspage.Metric, *statuspage.Response, error) {
+	m.record("UpdateMetric", pageID, metricID, metric)
+	if m.UpdateMetricFunc != nil {
+		return m.UpdateMetricFunc(ctx, pageID, metricID, metric)
+	}
+	return nil, nil, notMocked("MetricAPI.UpdateMetric")
+}
+
+func (m *MetricAPI) DeleteMetric(ctx context.Context, pageID string, metricID string) (*statuspage.Response, error) {
+	m.record("DeleteMetric", pageID, metricID)
+	if m.DeleteMetricFunc != nil {
+		return m.DeleteMetricFunc(ctx, pageID, metricID)
+	}
+	return nil, notMocked("MetricAPI.DeleteMetric")
+}
+
+func (m *MetricAPI) SubmitDataPoint(ctx context.Context, pageID string, metricID string, point statuspage.MetricDataPoint) (*statuspage.Response, error) {
+	m.record("SubmitDataPoint", pageID, metricID, point)
+	if m.SubmitDataPointFunc != nil {
+		return m.SubmitDataPointFunc(ctx, pageID, metricID, point)
+	}
+	return nil, notMocked("MetricAPI.SubmitDataPoint")
+}
+
+func (m *MetricAPI) SubmitDataPoints(ctx context.Context, pageID string, points map[string][]statuspage.MetricDataPoint) (*statuspage.Response, error) {
+	m.record("SubmitDataPoints", pageID, points)
+	if m.SubmitDataPointsFunc != nil {
+		return m.SubmitDataPointsFunc(ctx, pageID, points)
+	}
+	return nil, notMocked("MetricAPI.SubmitDataPoints")
+}
+
+func (m *MetricAPI) ResetMetricData(ctx context.Context, pageID string, metricID string) (*statuspage.Response, error) {
+	m.record("ResetMetricData", pageID, metricID)
+	if m.ResetMetricDataFunc != nil {
+		return m.ResetMetricDataFunc(ctx, pageID, metricID)
+	}
+	return nil, notMocked("MetricAPI.ResetMetricData")
+}
@@ -0,0 +1,139 @@
+// Package statuspagemock provides mock implementations of the service
+// interfaces of the statuspage package, for unit tests of code that depends
+// on them.
+//
+// Every mock records its calls and delegates each method to the function
+// field named after it, e.g. PageAPI.GetPage calls PageAPI.GetPageFunc.
+// Methods without a function return an error wrapping ErrNotMocked, or an
+// iterator yielding one.
+//
+//	pages := &statuspagemock.PageAPI{
+//		GetPageFunc: func(ctx context.Context, pageID string) (*statuspage.Page, *statuspage.Response, error) {
+//			return &statuspage.Page{ID: &pageID}, nil, nil
+//		},
+//	}
+//	client := statuspage.NewClient("")
+//	client.Page = pages
+//
+//	// Exercise code using client, then inspect pages.Calls().
+package statuspagemock
+
+import (
+	"errors"
+	"fmt"
+	"iter"
+	"sync"
+
+	"github.com/nagelflorian/statuspage-go"
+)
+
+// ErrNotMocked is wrapped by the errors of mock methods without a function.
+var ErrNotMocked = errors.New("statuspagemock: method not mocked")
+
+// Call is a recorded method call.
+type Call struct {
+	// Method is the name of the called method, e.g. "GetPage".
+	Method string
+
+	// Args are the arguments of the call, without the context.
+	Args []interface{}
+}
+
+// Recorder records the calls of a mock. It is embedded in every mock and safe
+// for concurrent use by multiple goroutines.
+type Recorder struct {
+	mu    sync.Mutex
+	calls []Call
+}
+
+// Calls returns the recorded calls in the order they were made.
+func (r *Recorder) Calls() []Call {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]Call(nil), r.calls...)
+}
+
+// CallsTo returns the recorded calls of the named method in the order they
+// were made.
+func (r *Recorder) CallsTo(method string) []Call {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var calls []Call
+	for _, call := range r.calls {
+		if call.Method == method {
+			calls = append(calls, call)
+		}
+	}
+	return calls
+}
+
+// Reset forgets all recorded calls.
+func (r *Recorder) Reset() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.calls = nil
+}
+
+func (r *Recorder) record(method string, args ...interface{}) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.calls = append(r.calls, Call{Method: method, Args: args})
+}
+
+func notMocked(method string) error {
+	return fmt.Errorf("%w: %s", ErrNotMocked, method)
+}
+
+func notMockedSeq[T any](method string) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var zero T
+		yield(zero, notMocked(method))
+	}
+}
+
+// Services holds a mock for every service of a statuspage.Client.
+type Services struct {
+	Page            *PageAPI
+	Component       *ComponentAPI
+	ComponentGroup  *ComponentGroupAPI
+	Incident        *IncidentAPI
+	Metric          *MetricAPI
+	Organization    *OrganizationAPI
+	PageAccessUser  *PageAccessUserAPI
+	PageAccessGroup *PageAccessGroupAPI
+	Subscriber      *SubscriberAPI
+	Template        *TemplateAPI
+}
+
+// NewServices returns Services with a new mock for every service.
+func NewServices() *Services {
+	return &Services{
+		Page:            &PageAPI{},
+		Component:       &ComponentAPI{},
+		ComponentGroup:  &ComponentGroupAPI{},
+		Incident:        &IncidentAPI{},
+		Metric:          &MetricAPI{},
+		Organization:    &OrganizationAPI{},
+		PageAccessUser:  &PageAccessUserAPI{},
+		PageAccessGroup: &PageAccessGroupAPI{},
+		Subscriber:      &SubscriberAPI{},
+		Template:        &TemplateAPI{},
+	}
+}
+
+// Client returns a statuspage.Client whose services are the mocks of s.
+func (s *Services) Client() *statuspage.Client {
+	c := statuspage.NewClient("")
+	c.Page = s.Page
+	c.Component = s.Component
+	c.ComponentGroup = s.ComponentGroup
+	c.Incident = s.Incident
+	c.Metric = s.Metric
+	c.Organization = s.Organization
+	c.PageAccessUser = s.PageAccessUser
+	c.PageAccessGroup = s.PageAccessGroup
+	c.Subscriber = s.Subscriber
+	c.Template = s.Template
+	return c
+}
@@ -0,0 +1,115 @@
+package statuspagemock
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/nagelflorian/statuspage-go"
+)
+
+func TestPageAPI_GetPage(t *testing.T) {
+	pages := &PageAPI{
+		GetPageFunc: func(ctx context.Context, pageID string) (*statuspage.Page, *statuspage.Response, error) {
+			return &statuspage.Page{ID: &pageID}, nil, nil
+		},
+	}
+
+	page, _, err := pages.GetPage(context.Background(), "1")
+	if err != nil {
+		t.Fatalf("PageAPI.GetPage returned error: %v", err)
+	}
+	if *page.ID != "1" {
+		t.Errorf("PageAPI.GetPage returned page %q, want %q", *page.ID, "1")
+	}
+
+	want := []Call{{Method: "GetPage", Args: []interface{}{"1"}}}
+	if got := pages.Calls(); !reflect.DeepEqual(got, want) {
+		t.Errorf("PageAPI.Calls returned %+v, want %+v", got, want)
+	}
+}
+
+func TestPageAPI_notMocked(t *testing.T) {
+	pages := &PageAPI{}
+
+	if _, _, err := pages.GetPage(context.Background(), "1"); !errors.Is(err, ErrNotMocked) {
+		t.Errorf("PageAPI.GetPage returned error %v, want ErrNotMocked", err)
+	}
+	for _, err := range pages.All(context.Background()) {
+		if !errors.Is(err, ErrNotMocked) {
+			t.Errorf("PageAPI.All yielded error %v, want ErrNotMocked", err)
+		}
+	}
+	if got := len(pages.Calls()); got != 2 {
+		t.Errorf("PageAPI.Calls returned %d calls, want 2", got)
+	}
+}
+
+func TestRecorder(t *testing.T) {
+	components := &ComponentAPI{}
+	ctx := context.Background()
+	components.GetComponent(ctx, "1", "a")
+	components.DeleteComponent(ctx, "1", "b")
+	components.GetComponent(ctx, "1", "c")
+
+	want := []Call{
+		{Method: "GetComponent", Args: []interface{}{"1", "a"}},
+		{Method: "GetComponent", Args: []interface{}{"1", "c"}},
+	}
+	if got := components.CallsTo("GetComponent"); !reflect.DeepEqual(got, want) {
+		t.Errorf("ComponentAPI.CallsTo returned %+v, want %+v", got, want)
+	}
+
+	components.Reset()
+	if got := components.Calls(); len(got) != 0 {
+		t.Errorf("ComponentAPI.Calls returned %+v after Reset, want none", got)
+	}
+}
+
+func TestServices_Client(t *testing.T) {
+	services := NewServices()
+	services.Incident.ResolveIncidentFunc = func(ctx context.Context, pageID string, incidentID string, body string) (*statuspage.Incident, *statuspage.Response, error) {
+		return &statuspage.Incident{ID: &incidentID}, nil, nil
+	}
+
+	client := services.Client()
+	incident, _, err := client.Incident.ResolveIncident(context.Background(), "1", "i", "Fixed.")
+	if err != nil {
+		t.Fatalf("IncidentAPI.ResolveIncident returned error: %v", err)
+	}
+	if *incident.ID != "i" {
+		t.Errorf("IncidentAPI.ResolveIncident returned incident %q, want %q", *incident.ID, "i")
+	}
+
+	want := []Call{{Method: "ResolveIncident", Args: []interface{}{"1", "i", "Fixed."}}}
+	if got := services.Incident.Calls(); !reflect.DeepEqual(got, want) {
+		t.Errorf("IncidentAPI.Calls returned %+v, want %+v", got, want)
+	}
+}
+
+func TestMetricAPI_batcher(t *testing.T) {
+	metrics := &MetricAPI{
+		SubmitDataPointsFunc: func(ctx context.Context, pageID string, points map[string][]statuspage.MetricDataPoint) (*statuspage.Response, error) {
+			return nil, nil
+		},
+	}
+
+	b := statuspage.NewMetricBatcher(metrics, "1", &statuspage.MetricBatcherOptions{FlushInterval: time.Hour})
+	point := statuspage.MetricDataPoint{Timestamp: statuspage.Timestamp{Time: time.Now()}, Value: 1}
+	if err := b.Add("m", point); err != nil {
+		t.Fatalf("MetricBatcher.Add returned error: %v", err)
+	}
+	if err := b.Close(context.Background()); err != nil {
+		t.Fatalf("MetricBatcher.Close returned error: %v", err)
+	}
+
+	calls := metrics.CallsTo("SubmitDataPoints")
+	if len(calls) != 1 {
+		t.Fatalf("MetricAPI.CallsTo returned %d calls, want 1", len(calls))
+	}
+	if points := calls[0].Args[1].(map[string][]statuspage.MetricDataPoint); len(points["m"]) != 1 {
+		t.Errorf("MetricBatcher submitted %+v, want one point of m", points)
+	}
+}
@@ -0,0 +1,70 @@
+package statuspagemock
+
+import (
+	"context"
+	"iter"
+
+	"github.com/nagelflorian/statuspage-go"
+)
+
+// OrganizationAPI is a mock statuspage.OrganizationAPI.
+type OrganizationAPI struct {
+	Recorder
+
+	ListUsersFunc         func(ctx context.Context, organizationID string, opts *statuspage.ListOptions) (*[]statuspage.OrganizationUser, *statuspage.Response, error)
+	AllUsersFunc          func(ctx context.Context, organizationID string) iter.Seq2[statuspage.OrganizationUser, error]
+	CreateUserFunc        func(ctx context.Context, organizationID string, user statuspage.CreateUserParams) (*statuspage.OrganizationUser, *statuspage.Response, error)
+	DeleteUserFunc        func(ctx context.Context, organizationID string, userID string) (*statuspage.Response, error)
+	GetPermissionsFunc    func(ctx context.Context, organizationID string, userID string) (*statuspage.Permissions, *statuspage.Response, error)
+	UpdatePermissionsFunc func(ctx context.Context, organizationID string, userID string, permissions statuspage.UpdatePermissionsParams) (*statuspage.Permissions, *statuspage.Response, error)
+}
+
+var _ statuspage.OrganizationAPI = (*OrganizationAPI)(nil)
+
+func (m *OrganizationAPI) ListUsers(ctx context.Context, organizationID string, opts *statuspage.ListOptions) (*[]statuspage.OrganizationUser, *statuspage.Response, error) {
+	m.record("ListUsers", organizationID, opts)
+	if m.ListUsersFunc != nil {
+		return m.ListUsersFunc(ctx, organizationID, opts)
+	}
+	return nil, nil, notMocked("OrganizationAPI.ListUsers")
+}
+
+func (m *OrganizationAPI) AllUsers(ctx context.Context, organizationID string) iter.Seq2[statuspage.OrganizationUser, error] {
+	m.record("AllUsers", organizationID)
+	if m.AllUsersFunc != nil {
+		return m.AllUsersFunc(ctx, organizationID)
+	}
+	return notMockedSeq[statuspage.OrganizationUser]("OrganizationAPI.AllUsers")
+}
+
+func (m *OrganizationAPI) CreateUser(ctx context.Context, organizationID string, user statuspage.CreateUserParams) (*statuspage.OrganizationUser, *statuspage.Response, error) {
+	m.record("CreateUser", organizationID, user)
+	if m.CreateUserFunc != nil {
+		return m.CreateUserFunc(ctx, organizationID, user)
+	}
+	return nil, nil, notMocked("OrganizationAPI.CreateUser")
+}
+
+func (m *OrganizationAPI) DeleteUser(ctx context.Context, organizationID string, userID string) (*statuspage.Response, error) {
+	m.record("DeleteUser", organizationID, userID)
+	if m.DeleteUserFunc != nil {
+		return m.DeleteUserFunc(ctx, organizationID, userID)
+	}
+	return nil, notMocked("OrganizationAPI.DeleteUser")
+}
+
+func (m *OrganizationAPI) GetPermissions(ctx context.Context, organizationID string, userID string) (*statuspage.Permissions, *statuspage.Response, error) {
+	m.record("GetPermissions", organizationID, userID)
+	if m.GetPermissionsFunc != nil {
+		return m.GetPermissionsFunc(ctx, organizationID, userID)
+	}
+	return nil, nil, notMocked("OrganizationAPI.GetPermissions")
+}
+
+func (m *OrganizationAPI) UpdatePermissions(ctx context.Context, organizationID string, userID string, permissions statuspage.UpdatePermissionsParams) (*statuspage.Permissions, *statuspage.Response, error) {
+	m.record("UpdatePermissions", organizationID, userID, permissions)
+	if m.UpdatePermissionsFunc != nil {
+		return m.UpdatePermissionsFunc(ctx, organizationID, userID, permissions)
+	}
+	return nil, nil, notMocked("OrganizationAPI.UpdatePermissions")
+}
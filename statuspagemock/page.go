@@ -0,0 +1,52 @@
+package statuspagemock
+
+import (
+	"context"
+	"iter"
+
+	"github.com/nagelflorian/statuspage-go"
+)
+
+// PageAPI is a mock statuspage.PageAPI.
+type PageAPI struct {
+	Recorder
+
+	ListPagesFunc  func(ctx context.Context, opts *statuspage.ListOptions) (*[]statuspage.Page, *statuspage.Response, error)
+	AllFunc        func(ctx context.Context) iter.Seq2[statuspage.Page, error]
+	UpdatePageFunc func(ctx context.Context, pageID string, page statuspage.UpdatePageParams) (*statuspage.Page, *statuspage.Response, error)
+	GetPageFunc    func(ctx context.Context, pageID string) (*statuspage.Page, *statuspage.Response, error)
+}
+
+var _ statuspage.PageAPI = (*PageAPI)(nil)
+
+func (m *PageAPI) ListPages(ctx context.Context, opts *statuspage.ListOptions) (*[]statuspage.Page, *statuspage.Response, error) {
+	m.record("ListPages", opts)
+	if m.ListPagesFunc != nil {
+		return m.ListPagesFunc(ctx, opts)
+	}
+	return nil, nil, notMocked("PageAPI.ListPages")
+}
+
+func (m *PageAPI) All(ctx context.Context) iter.Seq2[statuspage.Page, error] {
+	m.record("All")
+	if m.AllFunc != nil {
+		return m.AllFunc(ctx)
+	}
+	return notMockedSeq[statuspage.Page]("PageAPI.All")
+}
+
+func (m *PageAPI) UpdatePage(ctx context.Context, pageID string, page statuspage.UpdatePageParams) (*statuspage.Page, *statuspage.Response, error) {
+	m.record("UpdatePage", pageID, page)
+	if m.UpdatePageFunc != nil {
+		return m.UpdatePageFunc(ctx, pageID, page)
+	}
+	return nil, nil, notMocked("PageAPI.UpdatePage")
+}
+
+func (m *PageAPI) GetPage(ctx context.Context, pageID string) (*statuspage.Page, *statuspage.Response, error) {
+	m.record("GetPage", pageID)
+	if m.GetPageFunc != nil {
+		return m.GetPageFunc(ctx, pageID)
+	}
+	return nil, nil, notMocked("PageAPI.GetPage")
+}
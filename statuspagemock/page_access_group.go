@@ -0,0 +1,115 @@
+package statuspagemock
+
+import (
+	"context"
+	"iter"
+
+	"github.com/nagelflorian/statuspage-go"
+)
+
+// PageAccessGroupAPI is a mock statuspage.PageAccessGroupAPI.
+type PageAccessGroupAPI struct {
+	Recorder
+
+	ListPageAccessGroupsFunc  func(ctx context.Context, pageID string, opts *statuspage.ListOptions) (*[]statuspage.PageAccessGroup, *statuspage.Response, error)
+	AllFunc                   func(ctx context.Context, pageID string) iter.Seq2[statuspage.PageAccessGroup, error]
+	GetPageAccessGroupFunc    func(ctx context.Context, pageID string, groupID string) (*statuspage.PageAccessGroup, *statuspage.Response, error)
+	CreatePageAccessGroupFunc func(ctx context.Context, pageID string, group statuspage.CreatePageAccessGroupParams) (*statuspage.PageAccessGroup, *statuspage.Response, error)
+	UpdatePageAccessGroupFunc func(ctx context.Context, pageID string, groupID string, group statuspage.UpdatePageAccessGroupParams) (*statuspage.PageAccessGroup, *statuspage.Response, error)
+	DeletePageAccessGroupFunc func(ctx context.Context, pageID string, groupID string) (*statuspage.Response, error)
+	ListComponentsFunc        func(ctx context.Context, pageID string, groupID string, opts *statuspage.ListOptions) (*[]statuspage.Component, *statuspage.Response, error)
+	AddComponentsFunc         func(ctx context.Context, pageID string, groupID string, componentIDs []string) (*statuspage.PageAccessGroup, *statuspage.Response, error)
+	ReplaceComponentsFunc     func(ctx context.Context, pageID string, groupID string, componentIDs []string) (*statuspage.PageAccessGroup, *statuspage.Response, error)
+	RemoveComponentsFunc      func(ctx context.Context, pageID string, groupID string, componentIDs []string) (*statuspage.PageAccessGroup, *statuspage.Response, error)
+	RemoveComponentFunc       func(ctx context.Context, pageID string, groupID string, componentID string) (*statuspage.Response, error)
+}
+
+var _ statuspage.PageAccessGroupAPI = (*PageAccessGroupAPI)(nil)
+
+func (m *PageAccessGroupAPI) ListPageAccessGroups(ctx context.Context, pageID string, opts *statuspage.ListOptions) (*[]statuspage.PageAccessGroup, *statuspage.Response, error) {
+	m.record("ListPageAccessGroups", pageID, opts)
+	if m.ListPageAccessGroupsFunc != nil {
+		return m.ListPageAccessGroupsFunc(ctx, pageID, opts)
+	}
+	return nil, nil, notMocked("PageAccessGroupAPI.ListPageAccessGroups")
+}
+
+func (m *PageAccessGroupAPI) All(ctx context.Context, pageID string) iter.Seq2[statuspage.PageAccessGroup, error] {
+	m.record("All", pageID)
+	if m.AllFunc != nil {
+		return m.AllFunc(ctx, pageID)
+	}
+	return notMockedSeq[statuspage.PageAccessGroup]("PageAccessGroupAPI.All")
+}
+
+func (m *PageAccessGroupAPI) GetPageAccessGroup(ctx context.Context, pageID string, groupID string) (*statuspage.PageAccessGroup, *statuspage.Response, error) {
+	m.record("GetPageAccessGroup", pageID, groupID)
+	if m.GetPageAccessGroupFunc != nil {
+		return m.GetPageAccessGroupFunc(ctx, pageID, groupID)
+	}
+	return nil, nil, notMocked("PageAccessGroupAPI.GetPageAccessGroup")
+}
+
+func (m *PageAccessGroupAPI) CreatePageAccessGroup(ctx context.Context, pageID string, group statuspage.CreatePageAccessGroupParams) (*statuspage.PageAccessGroup, *statuspage.Response, error) {
+	m.record("CreatePageAccessGroup", pageID, group)
+	if m.CreatePageAccessGroupFunc != nil {
+		return m.CreatePageAccessGroupFunc(ctx, pageID, group)
+	}
+	return nil, nil, notMocked("PageAccessGroupAPI.CreatePageAccessGroup")
+}
+
+func (m *PageAccessGroupAPI) UpdatePageAccessGroup(ctx context.Context, pageID string, groupID string, group statuspage.UpdatePageAccessGroupParams) (*statuspage.PageAccessGroup, *statuspage.Response, error) {
+	m.record("UpdatePageAccessGroup", pageID, groupID, group)
+	if m.UpdatePageAccessGroupFunc != nil {
+		return m.UpdatePageAccessGroupFunc(ctx, pageID, groupID, group)
+	}
+	return nil, nil, notMocked("PageAccessGroupAPI.UpdatePageAccessGroup")
+}
+
+func (m *PageAccessGroupAPI) DeletePageAccessGroup(ctx context.Context, pageID string, groupID string) (*statuspage.Response, error) {
+	m.record("DeletePageAccessGroup", pageID, groupID)
+	if m.DeletePageAccessGroupFunc != nil {
+		return m.DeletePageAccessGroupFunc(ctx, pageID, groupID)
+	}
+	return nil, notMocked("PageAccessGroupAPI.DeletePageAccessGroup")
+}
+
+func (m *PageAccessGroupAPI) ListComponents(ctx context.Context, pageID string, groupID string, opts *statuspage.ListOptions) (*[]statuspage.Component, *statuspage.Response, error) {
+	m.record("ListComponents", pageID, groupID, opts)
+	if m.ListComponentsFunc != nil {
+		return m.ListComponentsFunc(ctx, pageID, groupID, opts)
+	}
+	return nil, nil, notMocked("PageAccessGroupAPI.ListComponents")
+}
+
+func (m *PageAccessGroupAPI) AddComponents(ctx context.Context, pageID string, groupID string, componentIDs []string) (*statuspage.PageAccessGroup, *statuspage.Response, error) {
+	m.record("AddComponents", pageID, groupID, componentIDs)
+	if m.AddComponentsFunc != nil {
+		return m.AddComponentsFunc(ctx, pageID, groupID, componentIDs)
+	}
+	return nil, nil, notMocked("PageAccessGroupAPI.AddComponents")
+}
+
+func (m *PageAccessGroupAPI) ReplaceComponents(ctx context.Context, pageID string, groupID string, componentIDs []string) (*statuspage.PageAccessGroup, *statuspage.Response, error) {
+	m.record("ReplaceComponents", pageID, groupID, componentIDs)
+	if m.ReplaceComponentsFunc != nil {
+		return m.ReplaceComponentsFunc(ctx, pageID, groupID, componentIDs)
+	}
+	return nil, nil, notMocked("PageAccessGroupAPI.ReplaceComponents")
+}
+
+func (m *PageAccessGroupAPI) RemoveComponents(ctx context.Context, pageID string, groupID string, componentIDs []string) (*statuspage.PageAccessGroup, *statuspage.Response, error) {
+	m.record("RemoveComponents", pageID, groupID, componentIDs)
+	if m.RemoveComponentsFunc != nil {
+		return m.RemoveComponentsFunc(ctx, pageID, groupID, componentIDs)
+	}
+	return nil, nil, notMocked("PageAccessGroupAPI.RemoveComponents")
+}
+
+func (m *PageAccessGroupAPI) RemoveComponent(ctx context.Context, pageID string, groupID string, componentID string) (*statuspage.Response, error) {
+	m.record("RemoveComponent", pageID, groupID, componentID)
+	if m.RemoveComponentFunc != nil {
+		return m.RemoveComponentFunc(ctx, pageID, groupID, componentID)
+	}
+	return nil, notMocked("PageAccessGroupAPI.RemoveComponent")
+}
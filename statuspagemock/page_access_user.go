@@ -0,0 +1,160 @@
+package statuspagemock
+
+import (
+	"context"
+	"iter"
+
+	"github.com/nagelflorian/statuspage-go"
+)
+
+// PageAccessUserAPI is a mock statuspage.PageAccessUserAPI.
+type PageAccessUserAPI struct {
+	Recorder
+
+	ListPageAccessUsersFunc  func(ctx context.Context, pageID string, opts *statuspage.PageAccessUserListOptions) (*[]statuspage.PageAccessUser, *statuspage.Response, error)
+	AllFunc                  func(ctx context.Context, pageID string, opts statuspage.PageAccessUserListOptions) iter.Seq2[statuspage.PageAccessUser, error]
+	GetPageAccessUserFunc    func(ctx context.Context, pageID string, userID string) (*statuspage.PageAccessUser, *statuspage.Response, error)
+	CreatePageAccessUserFunc func(ctx context.Context, pageID string, user statuspage.CreatePageAccessUserParams) (*statuspage.PageAccessUser, *statuspage.Response, error)
+	UpdatePageAccessUserFunc func(ctx context.Context, pageID string, userID string, user statuspage.UpdatePageAccessUserParams) (*statuspage.PageAccessUser, *statuspage.Response, error)
+	DeletePageAccessUserFunc func(ctx context.Context, pageID string, userID string) (*statuspage.Response, error)
+	ListComponentsFunc       func(ctx context.Context, pageID string, userID string, opts *statuspage.ListOptions) (*[]statuspage.Component, *statuspage.Response, error)
+	AddComponentsFunc        func(ctx context.Context, pageID string, userID string, componentIDs []string) (*statuspage.PageAccessUser, *statuspage.Response, error)
+	ReplaceComponentsFunc    func(ctx context.Context, pageID string, userID string, componentIDs []string) (*statuspage.PageAccessUser, *statuspage.Response, error)
+	RemoveComponentsFunc     func(ctx context.Context, pageID string, userID string, componentIDs []string) (*statuspage.PageAccessUser, *statuspage.Response, error)
+	RemoveComponentFunc      func(ctx context.Context, pageID string, userID string, componentID string) (*statuspage.Response, error)
+	ListMetricsFunc          func(ctx context.Context, pageID string, userID string, opts *statuspage.ListOptions) (*[]statuspage.Metric, *statuspage.Response, error)
+	AddMetricsFunc           func(ctx context.Context, pageID string, userID string, metricIDs []string) (*statuspage.PageAccessUser, *statuspage.Response, error)
+	ReplaceMetricsFunc       func(ctx context.Context, pageID string, userID string, metricIDs []string) (*statuspage.PageAccessUser, *statuspage.Response, error)
+	RemoveMetricsFunc        func(ctx context.Context, pageID string, userID string, metricIDs []string) (*statuspage.PageAccessUser, *statuspage.Response, error)
+	RemoveMetricFunc         func(ctx context.Context, pageID string, userID string, metricID string) (*statuspage.Response, error)
+}
+
+var _ statuspage.PageAccessUserAPI = (*PageAccessUserAPI)(nil)
+
+func (m *PageAccessUserAPI) ListPageAccessUsers(ctx context.Context, pageID string, opts *statuspage.PageAccessUserListOptions) (*[]statuspage.PageAccessUser, *statuspage.Response, error) {
+	m.record("ListPageAccessUsers", pageID, opts)
+	if m.ListPageAccessUsersFunc != nil {
+		return m.ListPageAccessUsersFunc(ctx, pageID, opts)
+	}
+	return nil, nil, notMocked("PageAccessUserAPI.ListPageAccessUsers")
+}
+
+func (m *PageAccessUserAPI) All(ctx context.Context, pageID string, opts statuspage.PageAccessUserListOptions) iter.Seq2[statuspage.PageAccessUser, error] {
+	m.record("All", pageID, opts)
+	if m.AllFunc != nil {
+		return m.AllFunc(ctx, pageID, opts)
+	}
+	return notMockedSeq[statuspage.PageAccessUser]("PageAccessUserAPI.All")
+}
+
+func (m *PageAccessUserAPI) GetPageAccessUser(ctx context.Context, pageID string, userID string) (*statuspage.PageAccessUser, *statuspage.Response, error) {
+	m.record("GetPageAccessUser", pageID, userID)
+	if m.GetPageAccessUserFunc != nil {
+		return m.GetPageAccessUserFunc(ctx, pageID, userID)
+	}
+	return nil, nil, notMocked("PageAccessUserAPI.GetPageAccessUser")
+}
+
+func (m *PageAccessUserAPI) CreatePageAccessUser(ctx context.Context, pageID string, user statuspage.CreatePageAccessUserParams) (*statuspage.PageAccessUser, *statuspage.Response, error) {
+	m.record("CreatePageAccessUser", pageID, user)
+	if m.CreatePageAccessUserFunc != nil {
+		return m.CreatePageAccessUserFunc(ctx, pageID, user)
+	}
+	return nil, nil, notMocked("PageAccessUserAPI.CreatePageAccessUser")
+}
+
+func (m *PageAccessUserAPI) UpdatePageAccessUser(ctx context.Context, pageID string, userID string, user statuspage.UpdatePageAccessUserParams) (*statuspage.PageAccessUser, *statuspage.Response, error) {
+	m.record("UpdatePageAccessUser", pageID, userID, user)
+	if m.UpdatePageAccessUserFunc != nil {
+		return m.UpdatePageAccessUserFunc(ctx, pageID, userID, user)
+	}
+	return nil, nil, notMocked("PageAccessUserAPI.UpdatePageAccessUser")
+}
+
+func (m *PageAccessUserAPI) DeletePageAccessUser(ctx context.Context, pageID string, userID string) (*statuspage.Response, error) {
+	m.record("DeletePageAccessUser", pageID, userID)
+	if m.DeletePageAccessUserFunc != nil {
+		return m.DeletePageAccessUserFunc(ctx, pageID, userID)
+	}
+	return nil, notMocked("PageAccessUserAPI.DeletePageAccessUser")
+}
+
+func (m *PageAccessUserAPI) ListComponents(ctx context.Context, pageID string, userID string, opts *statuspage.ListOptions) (*[]statuspage.Component, *statuspage.Response, error) {
+	m.record("ListComponents", pageID, userID, opts)
+	if m.ListComponentsFunc != nil {
+		return m.ListComponentsFunc(ctx, pageID, userID, opts)
+	}
+	return nil, nil, notMocked("PageAccessUserAPI.ListComponents")
+}
+
+func (m *PageAccessUserAPI) AddComponents(ctx context.Context, pageID string, userID string, componentIDs []string) (*statuspage.PageAccessUser, *statuspage.Response, error) {
+	m.record("AddComponents", pageID, userID, componentIDs)
+	if m.AddComponentsFunc != nil {
+		return m.AddComponentsFunc(ctx, pageID, userID, componentIDs)
+	}
+	return nil, nil, notMocked("PageAccessUserAPI.AddComponents")
+}
+
+func (m *PageAccessUserAPI) ReplaceComponents(ctx context.Context, pageID string, userID string, componentIDs []string) (*statuspage.PageAccessUser, *statuspage.Response, error) {
+	m.record("ReplaceComponents", pageID, userID, componentIDs)
+	if m.ReplaceComponentsFunc != nil {
+		return m.ReplaceComponentsFunc(ctx, pageID, userID, componentIDs)
+	}
+	return nil, nil, notMocked("PageAccessUserAPI.ReplaceComponents")
+}
+
+func (m *PageAccessUserAPI) RemoveComponents(ctx context.Context, pageID string, userID string, componentIDs []string) (*statuspage.PageAccessUser, *statuspage.Response, error) {
+	m.record("RemoveComponents", pageID, userID, componentIDs)
+	if m.RemoveComponentsFunc != nil {
+		return m.RemoveComponentsFunc(ctx, pageID, userID, componentIDs)
+	}
+	return nil, nil, notMocked("PageAccessUserAPI.RemoveComponents")
+}
+
+func (m *PageAccessUserAPI) RemoveComponent(ctx context.Context, pageID string, userID string, componentID string) (*statuspage.Response, error) {
+	m.record("RemoveComponent", pageID, userID, componentID)
+	if m.RemoveComponentFunc != nil {
+		return m.RemoveComponentFunc(ctx, pageID, userID, componentID)
+	}
+	return nil, notMocked("PageAccessUserAPI.RemoveComponent")
+}
+
+func (m *PageAccessUserAPI) ListMetrics(ctx context.Context, pageID string, userID string, opts *statuspage.ListOptions) (*[]statuspage.Metric, *statuspage.Response, error) {
+	m.record("ListMetrics", pageID, userID, opts)
+	if m.ListMetricsFunc != nil {
+		return m.ListMetricsFunc(ctx, pageID, userID, opts)
+	}
+	return nil, nil, notMocked("PageAccessUserAPI.ListMetrics")
+}
+
+func (m *PageAccessUserAPI) AddMetrics(ctx context.Context, pageID string, userID string, metricIDs []string) (*statuspage.PageAccessUser, *statuspage.Response, error) {
+	m.record("AddMetrics", pageID, userID, metricIDs)
+	if m.AddMetricsFunc != nil {
+		return m.AddMetricsFunc(ctx, pageID, userID, metricIDs)
+	}
+	return nil, nil, notMocked("PageAccessUserAPI.AddMetrics")
+}
+
+func (m *PageAccessUserAPI) ReplaceMetrics(ctx context.Context, pageID string, userID string, metricIDs []string) (*statuspage.PageAccessUser, *statuspage.Response, error) {
+	m.record("ReplaceMetrics", pageID, userID, metricIDs)
+	if m.ReplaceMetricsFunc != nil {
+		return m.ReplaceMetricsFunc(ctx, pageID, userID, metricIDs)
+	}
+	return nil, nil, notMocked("PageAccessUserAPI.ReplaceMetrics")
+}
+
+func (m *PageAccessUserAPI) RemoveMetrics(ctx context.Context, pageID string, userID string, metricIDs []string) (*statuspage.PageAccessUser, *statuspage.Response, error) {
+	m.record("RemoveMetrics", pageID, userID, metricIDs)
+	if m.RemoveMetricsFunc != nil {
+		return m.RemoveMetricsFunc(ctx, pageID, userID, metricIDs)
+	}
+	return nil, nil, notMocked("PageAccessUserAPI.RemoveMetrics")
+}
+
+func (m *PageAccessUserAPI) RemoveMetric(ctx context.Context, pageID string, userID string, metricID string) (*statuspage.Response, error) {
+	m.record("RemoveMetric", pageID, userID, metricID)
+	if m.RemoveMetricFunc != nil {
+		return m.RemoveMetricFunc(ctx, pageID, userID, metricID)
+	}
+	return nil, notMocked("PageAccessUserAPI.RemoveMetric")
+}
@@ -0,0 +1,169 @@
+package statuspagemock
+
+import (
+	"context"
+	"iter"
+
+	"github.com/nagelflorian/statuspage-go"
+)
+
+// SubscriberAPI is a mock statuspage.SubscriberAPI.
+type SubscriberAPI struct {
+	Recorder
+
+	ListSubscribersFunc                      func(ctx context.Context, pageID string, opts *statuspage.SubscriberListOptions) (*[]statuspage.Subscriber, *statuspage.Response, error)
+	AllFunc                                  func(ctx context.Context, pageID string, opts statuspage.SubscriberListOptions) iter.Seq2[statuspage.Subscriber, error]
+	GetSubscriberFunc                        func(ctx context.Context, pageID string, subscriberID string) (*statuspage.Subscriber, *statuspage.Response, error)
+	CreateSubscriberFunc                     func(ctx context.Context, pageID string, subscriber statuspage.CreateSubscriberParams) (*statuspage.Subscriber, *statuspage.Response, error)
+	UpdateSubscriberFunc                     func(ctx context.Context, pageID string, subscriberID string, subscriber statuspage.UpdateSubscriberParams) (*statuspage.Subscriber, *statuspage.Response, error)
+	UnsubscribeSubscriberFunc                func(ctx context.Context, pageID string, subscriberID string) (*statuspage.Subscriber, *statuspage.Response, error)
+	ResendConfirmationFunc                   func(ctx context.Context, pageID string, subscriberID string) (*statuspage.Response, error)
+	ResendConfirmationsFunc                  func(ctx context.Context, pageID string, subscriberIDs []string) (*statuspage.Response, error)
+	UnsubscribeSubscribersFunc               func(ctx context.Context, pageID string, body statuspage.BulkSubscribersRequestBody) (*statuspage.Response, error)
+	ReactivateSubscribersFunc                func(ctx context.Context, pageID string, body statuspage.BulkSubscribersRequestBody) (*statuspage.Response, error)
+	CountSubscribersFunc                     func(ctx context.Context, pageID string, opts *statuspage.SubscriberCountOptions) (*statuspage.SubscriberCountByType, *statuspage.Response, error)
+	GetSubscriberHistogramFunc               func(ctx context.Context, pageID string) (*statuspage.SubscriberCountByState, *statuspage.Response, error)
+	ListIncidentSubscribersFunc              func(ctx context.Context, pageID string, incidentID string, opts *statuspage.ListOptions) (*[]statuspage.Subscriber, *statuspage.Response, error)
+	GetIncidentSubscriberFunc                func(ctx context.Context, pageID string, incidentID string, subscriberID string) (*statuspage.Subscriber, *statuspage.Response, error)
+	CreateIncidentSubscriberFunc             func(ctx context.Context, pageID string, incidentID string, subscriber statuspage.CreateIncidentSubscriberParams) (*statuspage.Subscriber, *statuspage.Response, error)
+	UnsubscribeIncidentSubscriberFunc        func(ctx context.Context, pageID string, incidentID string, subscriberID string) (*statuspage.Subscriber, *statuspage.Response, error)
+	ResendIncidentSubscriberConfirmationFunc func(ctx context.Context, pageID string, incidentID string, subscriberID string) (*statuspage.Response, error)
+}
+
+var _ statuspage.SubscriberAPI = (*SubscriberAPI)(nil)
+
+func (m *SubscriberAPI) ListSubscribers(ctx context.Context, pageID string, opts *statuspage.SubscriberListOptions) (*[]statuspage.Subscriber, *statuspage.Response, error) {
+	m.record("ListSubscribers", pageID, opts)
+	if m.ListSubscribersFunc != nil {
+		return m.ListSubscribersFunc(ctx, pageID, opts)
+	}
+	return nil, nil, notMocked("SubscriberAPI.ListSubscribers")
+}
+
+func (m *SubscriberAPI) All(ctx context.Context, pageID string, opts statuspage.SubscriberListOptions) iter.Seq2[statuspage.Subscriber, error] {
+	m.record("All", pageID, opts)
+	if m.AllFunc != nil {
+		return m.AllFunc(ctx, pageID, opts)
+	}
+	return notMockedSeq[statuspage.Subscriber]("SubscriberAPI.All")
+}
+
+func (m *SubscriberAPI) GetSubscriber(ctx context.Context, pageID string, subscriberID string) (*statuspage.Subscriber, *statuspage.Response, error) {
+	m.record("GetSubscriber", pageID, subscriberID)
+	if m.GetSubscriberFunc != nil {
+		return m.GetSubscriberFunc(ctx, pageID, subscriberID)
+	}
+	return nil, nil, notMocked("SubscriberAPI.GetSubscriber")
+}
+
+func (m *SubscriberAPI) CreateSubscriber(ctx context.Context, pageID string, subscriber statuspage.CreateSubscriberParams) (*statuspage.Subscriber, *statuspage.Response, error) {
+	m.record("CreateSubscriber", pageID, subscriber)
+	if m.CreateSubscriberFunc != nil {
+		return m.CreateSubscriberFunc(ctx, pageID, subscriber)
+	}
+	return nil, nil, notMocked("SubscriberAPI.CreateSubscriber")
+}
+
+func (m *SubscriberAPI) UpdateSubscriber(ctx context.Context, pageID string, subscriberID string, subscriber statuspage.UpdateSubscriberParams) (*statuspage.Subscriber, *statuspage.Response, error) {
+	m.record("UpdateSubscriber", pageID, subscriberID, subscriber)
+	if m.UpdateSubscriberFunc != nil {
+		return m.UpdateSubscriberFunc(ctx, pageID, subscriberID, subscriber)
+	}
+	return nil, nil, notMocked("SubscriberAPI.UpdateSubscriber")
+}
+
+func (m *SubscriberAPI) UnsubscribeSubscriber(ctx context.Context, pageID string, subscriberID string) (*statuspage.Subscriber, *statuspage.Response, error) {
+	m.record("UnsubscribeSubscriber", pageID, subscriberID)
+	if m.UnsubscribeSubscriberFunc != nil {
+		return m.UnsubscribeSubscriberFunc(ctx, pageID, subscriberID)
+	}
+	return nil, nil, notMocked("SubscriberAPI.UnsubscribeSubscriber")
+}
+
+func (m *SubscriberAPI) ResendConfirmation(ctx context.Context, pageID string, subscriberID string) (*statuspage.Response, error) {
+	m.record("ResendConfirmation", pageID, subscriberID)
+	if m.ResendConfirmationFunc != nil {
+		return m.ResendConfirmationFunc(ctx, pageID, subscriberID)
+	}
+	return nil, notMocked("SubscriberAPI.ResendConfirmation")
+}
+
+func (m *SubscriberAPI) ResendConfirmations(ctx context.Context, pageID string, subscriberIDs []string) (*statuspage.Response, error) {
+	m.record("ResendConfirmations", pageID, subscriberIDs)
+	if m.ResendConfirmationsFunc != nil {
+		return m.ResendConfirmationsFunc(ctx, pageID, subscriberIDs)
+	}
+	return nil, notMocked("SubscriberAPI.ResendConfirmations")
+}
+
+func (m *SubscriberAPI) UnsubscribeSubscribers(ctx context.Context, pageID string, body statuspage.BulkSubscribersRequestBody) (*statuspage.Response, error) {
+	m.record("UnsubscribeSubscribers", pageID, body)
+	if m.UnsubscribeSubscribersFunc != nil {
+		return m.UnsubscribeSubscribersFunc(ctx, pageID, body)
+	}
+	return nil, notMocked("SubscriberAPI.UnsubscribeSubscribers")
+}
+
+func (m *SubscriberAPI) ReactivateSubscribers(ctx context.Context, pageID string, body statuspage.BulkSubscribersRequestBody) (*statuspage.Response, error) {
+	m.record("ReactivateSubscribers", pageID, body)
+	if m.ReactivateSubscribersFunc != nil {
+		return m.ReactivateSubscribersFunc(ctx, pageID, body)
+	}
+	return nil, notMocked("SubscriberAPI.ReactivateSubscribers")
+}
+
+func (m *SubscriberAPI) CountSubscribers(ctx context.Context, pageID string, opts *statuspage.SubscriberCountOptions) (*statuspage.SubscriberCountByType, *statuspage.Response, error) {
+	m.record("CountSubscribers", pageID, opts)
+	if m.CountSubscribersFunc != nil {
+		return m.CountSubscribersFunc(ctx, pageID, opts)
+	}
+	return nil, nil, notMocked("SubscriberAPI.CountSubscribers")
+}
+
+func (m *SubscriberAPI) GetSubscriberHistogram(ctx context.Context, pageID string) (*statuspage.SubscriberCountByState, *statuspage.Response, error) {
+	m.record("GetSubscriberHistogram", pageID)
+	if m.GetSubscriberHistogramFunc != nil {
+		return m.GetSubscriberHistogramFunc(ctx, pageID)
+	}
+	return nil, nil, notMocked("SubscriberAPI.GetSubscriberHistogram")
+}
+
+func (m *SubscriberAPI) ListIncidentSubscribers(ctx context.Context, pageID string, incidentID string, opts *statuspage.ListOptions) (*[]statuspage.Subscriber, *statuspage.Response, error) {
+	m.record("ListIncidentSubscribers", pageID, incidentID, opts)
+	if m.ListIncidentSubscribersFunc != nil {
+		return m.ListIncidentSubscribersFunc(ctx, pageID, incidentID, opts)
+	}
+	return nil, nil, notMocked("SubscriberAPI.ListIncidentSubscribers")
+}
+
+func (m *SubscriberAPI) GetIncidentSubscriber(ctx context.Context, pageID string, incidentID string, subscriberID string) (*statuspage.Subscriber, *statuspage.Response, error) {
+	m.record("GetIncidentSubscriber", pageID, incidentID, subscriberID)
+	if m.GetIncidentSubscriberFunc != nil {
+		return m.GetIncidentSubscriberFunc(ctx, pageID, incidentID, subscriberID)
+	}
+	return nil, nil, notMocked("SubscriberAPI.GetIncidentSubscriber")
+}
+
+func (m *SubscriberAPI) CreateIncidentSubscriber(ctx context.Context, pageID string, incidentID string, subscriber statuspage.CreateIncidentSubscriberParams) (*statuspage.Subscriber, *statuspage.Response, error) {
+	m.record("CreateIncidentSubscriber", pageID, incidentID, subscriber)
+	if m.CreateIncidentSubscriberFunc != nil {
+		return m.CreateIncidentSubscriberFunc(ctx, pageID, incidentID, subscriber)
+	}
+	return nil, nil, notMocked("SubscriberAPI.CreateIncidentSubscriber")
+}
+
+func (m *SubscriberAPI) UnsubscribeIncidentSubscriber(ctx context.Context, pageID string, incidentID string, subscriberID string) (*statuspage.Subscriber, *statuspage.Response, error) {
+	m.record("UnsubscribeIncidentSubscriber", pageID, incidentID, subscriberID)
+	if m.UnsubscribeIncidentSubscriberFunc != nil {
+		return m.UnsubscribeIncidentSubscriberFunc(ctx, pageID, incidentID, subscriberID)
+	}
+	return nil, nil, notMocked("SubscriberAPI.UnsubscribeIncidentSubscriber")
+}
+
+func (m *SubscriberAPI) ResendIncidentSubscriberConfirmation(ctx context.Context, pageID string, incidentID string, subscriberID string) (*statuspage.Response, error) {
+	m.record("ResendIncidentSubscriberConfirmation", pageID, incidentID, subscriberID)
+	if m.ResendIncidentSubscriberConfirmationFunc != nil {
+		return m.ResendIncidentSubscriberConfirmationFunc(ctx, pageID, incidentID, subscriberID)
+	}
+	return nil, notMocked("SubscriberAPI.ResendIncidentSubscriberConfirmation")
+}
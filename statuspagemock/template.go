@@ -0,0 +1,43 @@
+package statuspagemock
+
+import (
+	"context"
+	"iter"
+
+	"github.com/nagelflorian/statuspage-go"
+)
+
+// TemplateAPI is a mock statuspage.TemplateAPI.
+type TemplateAPI struct {
+	Recorder
+
+	ListTemplatesFunc  func(ctx context.Context, pageID string, opts *statuspage.ListOptions) (*[]statuspage.IncidentTemplate, *statuspage.Response, error)
+	AllFunc            func(ctx context.Context, pageID string) iter.Seq2[statuspage.IncidentTemplate, error]
+	CreateTemplateFunc func(ctx context.Context, pageID string, template statuspage.CreateTemplateParams) (*statuspage.IncidentTemplate, *statuspage.Response, error)
+}
+
+var _ statuspage.TemplateAPI = (*TemplateAPI)(nil)
+
+func (m *TemplateAPI) ListTemplates(ctx context.Context, pageID string, opts *statuspage.ListOptions) (*[]statuspage.IncidentTemplate, *statuspage.Response, error) {
+	m.record("ListTemplates", pageID, opts)
+	if m.ListTemplatesFunc != nil {
+		return m.ListTemplatesFunc(ctx, pageID, opts)
+	}
+	return nil, nil, notMocked("TemplateAPI.ListTemplates")
+}
+
+func (m *TemplateAPI) All(ctx context.Context, pageID string) iter.Seq2[statuspage.IncidentTemplate, error] {
+	m.record("All", pageID)
+	if m.AllFunc != nil {
+		return m.AllFunc(ctx, pageID)
+	}
+	return notMockedSeq[statuspage.IncidentTemplate]("TemplateAPI.All")
+}
+
+func (m *TemplateAPI) CreateTemplate(ctx context.Context, pageID string, template statuspage.CreateTemplateParams) (*statuspage.IncidentTemplate, *statuspage.Response, error) {
+	m.record("CreateTemplate", pageID, template)
+	if m.CreateTemplateFunc != nil {
+		return m.CreateTemplateFunc(ctx, pageID, template)
+	}
+	return nil, nil, notMocked("TemplateAPI.CreateTemplate")
+}
@@ -0,0 +1,964 @@
+package statuspagetest
+
+import (
+	"encoding/json"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/nagelflorian/statuspage-go"
+)
+
+// defaultPerPage is the page size of list endpoints without per_page.
+const defaultPerPage = 100
+
+func (s *Server) routes() http.Handler {
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("GET /v1/pages", s.listPages)
+	mux.HandleFunc("GET /v1/pages/{page}", s.getPage)
+	mux.HandleFunc("PATCH /v1/pages/{page}", s.updatePage)
+
+	mux.HandleFunc("GET /v1/pages/{page}/components", s.listComponents)
+	mux.HandleFunc("POST /v1/pages/{page}/components", s.createComponent)
+	mux.HandleFunc("GET /v1/pages/{page}/components/{component}", s.getComponent)
+	mux.HandleFunc("PATCH /v1/pages/{page}/components/{component}", s.updateComponent)
+	mux.HandleFunc("DELETE /v1/pages/{page}/components/{component}", s.deleteComponent)
+
+	mux.HandleFunc("GET /v1/pages/{page}/component-groups", s.listComponentGroups)
+	mux.HandleFunc("POST /v1/pages/{page}/component-groups", s.createComponentGroup)
+	mux.HandleFunc("GET /v1/pages/{page}/component-groups/{group}", s.getComponentGroup)
+	mux.HandleFunc("PATCH /v1/pages/{page}/component-groups/{group}", s.updateComponentGroup)
+	mux.HandleFunc("DELETE /v1/pages/{page}/component-groups/{group}", s.deleteComponentGroup)
+
+	mux.HandleFunc("GET /v1/pages/{page}/incidents", s.listIncidents(func(*statuspage.Incident) bool { return true }))
+	mux.HandleFunc("GET /v1/pages/{page}/incidents/unresolved", s.listIncidents(hasStatus(
+		statuspage.IncidentStatusInvestigating, statuspage.IncidentStatusIdentified, statuspage.IncidentStatusMonitoring)))
+	mux.HandleFunc("GET /v1/pages/{page}/incidents/upcoming", s.listIncidents(hasStatus(statuspage.IncidentStatusScheduled)))
+	mux.HandleFunc("GET /v1/pages/{page}/incidents/active_maintenance", s.listIncidents(hasStatus(
+		statuspage.IncidentStatusInProgress, statuspage.IncidentStatusVerifying)))
+	mux.HandleFunc("GET /v1/pages/{page}/incidents/scheduled", s.listIncidents(func(i *statuspage.Incident) bool {
+		return i.ScheduledFor != nil
+	}))
+	mux.HandleFunc("POST /v1/pages/{page}/incidents", s.createIncident)
+	mux.HandleFunc("GET /v1/pages/{page}/incidents/{incident}", s.getIncident)
+	mux.HandleFunc("PATCH /v1/pages/{page}/incidents/{incident}", s.updateIncident)
+	mux.HandleFunc("DELETE /v1/pages/{page}/incidents/{incident}", s.deleteIncident)
+
+	mux.HandleFunc("GET /v1/pages/{page}/subscribers", s.listSubscribers)
+	mux.HandleFunc("POST /v1/pages/{page}/subscribers", s.createSubscriber)
+	mux.HandleFunc("GET /v1/pages/{page}/subscribers/{subscriber}", s.getSubscriber)
+	mux.HandleFunc("PATCH /v1/pages/{page}/subscribers/{subscriber}", s.updateSubscriber)
+	mux.HandleFunc("DELETE /v1/pages/{page}/subscribers/{subscriber}", s.deleteSubscriber)
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		writeError(w, http.StatusNotFound, "Not found")
+	})
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if s.intercept(w, r) {
+			return
+		}
+		mux.ServeHTTP(w, r)
+	})
+}
+
+func (s *Server) listPages(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	pages := make([]statuspage.Page, 0, len(s.pages))
+	for _, p := range s.pages {
+		pages = append(pages, p.page)
+	}
+	writeJSON(w, http.StatusOK, paginate(r, pages))
+}
+
+func (s *Server) getPage(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	p, ok := s.findPage(w, r)
+	if !ok {
+		return
+	}
+	writeJSON(w, http.StatusOK, p.page)
+}
+
+func (s *Server) updatePage(w http.ResponseWriter, r *http.Request) {
+	var body statuspage.UpdatePageRequestBody
+	if !decode(w, r, &body) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	p, ok := s.findPage(w, r)
+	if !ok {
+		return
+	}
+
+	params, page := body.Page, &p.page
+	setString(&page.Name, params.Name)
+	setString(&page.Domain, params.Domain)
+	setString(&page.Subdomain, params.Subdomain)
+	setString(&page.URL, params.URL)
+	setString(&page.Branding, params.Branding)
+	setString(&page.CSSBodyBackgroundColor, params.CSSBodyBackgroundColor)
+	setString(&page.CSSFontColor, params.CSSFontColor)
+	setString(&page.CSSLightFontColor, params.CSSLightFontColor)
+	setString(&page.CSSGreens, params.CSSGreens)
+	setString(&page.CSSYellows, params.CSSYellows)
+	setString(&page.CSSOranges, params.CSSOranges)
+	setString(&page.CSSReds, params.CSSReds)
+	setString(&page.CSSBlues, params.CSSBlues)
+	setString(&page.CSSBorderColor, params.CSSBorderColor)
+	setString(&page.CSSGraphColor, params.CSSGraphColor)
+	setString(&page.CSSLinkColor, params.CSSLinkColor)
+	setString(&page.NotificationsFromEmail, params.NotificationsFromEmail)
+	setString(&page.TimeZone, params.TimeZone)
+	setString(&page.NotificationsEmailFooter, params.NotificationsEmailFooter)
+	setBool(&page.HiddenFromSearch, params.HiddenFromSearch)
+	setBool(&page.ViewersMustBeTeamMembers, params.ViewersMustBeTeamMembers)
+	setBool(&page.AllowPageSubscribers, params.AllowPageSubscribers)
+	setBool(&page.AllowIncidentSubscribers, params.AllowIncidentSubscribers)
+	setBool(&page.AllowEmailSubscribers, params.AllowEmailSubscribers)
+	setBool(&page.AllowSmsSubscribers, params.AllowSmsSubscribers)
+	setBool(&page.AllowRssAtomFeeds, params.AllowRssAtomFeeds)
+	setBool(&page.AllowWebhookSubscribers, params.AllowWebhookSubscribers)
+	page.UpdatedAt = now()
+
+	writeJSON(w, http.StatusOK, p.page)
+}
+
+func (s *Server) listComponents(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	p, ok := s.findPage(w, r)
+	if !ok {
+		return
+	}
+	writeJSON(w, http.StatusOK, paginate(r, values(p.components)))
+}
+
+func (s *Server) getComponent(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	_, component, ok := s.findComponent(w, r)
+	if !ok {
+		return
+	}
+	writeJSON(w, http.StatusOK, component)
+}
+
+func (s *Server) createComponent(w http.ResponseWriter, r *http.Request) {
+	var body statuspage.CreateComponentRequestBody
+	if !decode(w, r, &body) {
+		return
+	}
+	params := body.Component
+
+	var errs []string
+	if params.Name == "" {
+		errs = append(errs, "Name can't be blank")
+	}
+	if params.Status != "" && !params.Status.Valid() {
+		errs = append(errs, "Status is not included in the list")
+	}
+	if len(errs) > 0 {
+		writeErrors(w, http.StatusUnprocessableEntity, errs)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	p, ok := s.findPage(w, r)
+	if !ok {
+		return
+	}
+
+	status := params.Status
+	if status == "" {
+		status = statuspage.ComponentStatusOperational
+	}
+	position := int32(len(p.components) + 1)
+	createdAt := now()
+	component := &statuspage.Component{
+		ID:                 newID(),
+		PageID:             p.page.ID,
+		CreatedAt:          createdAt,
+		UpdatedAt:          createdAt,
+		Name:               stringPtr(params.Name),
+		Position:           &position,
+		Status:             stringPtr(string(status)),
+		Showcase:           &params.Showcase,
+		OnlyShowIfDegraded: &params.OnlyShowIfDegraded,
+	}
+	setString(&component.Description, params.Description)
+	p.components = append(p.components, component)
+	p.moveToGroup(component, params.GroupID)
+
+	writeJSON(w, http.StatusCreated, component)
+}
+
+func (s *Server) updateComponent(w http.ResponseWriter, r *http.Request) {
+	var body statuspage.UpdateComponentRequestBody
+	if !decode(w, r, &body) {
+		return
+	}
+	params := body.Component
+
+	if params.Status != "" && !params.Status.Valid() {
+		writeErrors(w, http.StatusUnprocessableEntity, []string{"Status is not included in the list"})
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	p, component, ok := s.findComponent(w, r)
+	if !ok {
+		return
+	}
+
+	setString(&component.Name, params.Name)
+	setString(&component.Description, params.Description)
+	setString(&component.Status, string(params.Status))
+	p.moveToGroup(component, params.GroupID)
+	if params.Showcase {
+		component.Showcase = &params.Showcase
+	}
+	if params.OnlyShowIfDegraded {
+		component.OnlyShowIfDegraded = &params.OnlyShowIfDegraded
+	}
+	component.UpdatedAt = now()
+
+	writeJSON(w, http.StatusOK, component)
+}
+
+func (s *Server) deleteComponent(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	p, component, ok := s.findComponent(w, r)
+	if !ok {
+		return
+	}
+	p.components = slices.DeleteFunc(p.components, func(c *statuspage.Component) bool { return c == component })
+	for _, group := range p.groups {
+		group.Components = slices.DeleteFunc(group.Components, func(id string) bool { return id == *component.ID })
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) listComponentGroups(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	p, ok := s.findPage(w, r)
+	if !ok {
+		return
+	}
+	writeJSON(w, http.StatusOK, paginate(r, values(p.groups)))
+}
+
+func (s *Server) getComponentGroup(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	_, group, ok := s.findComponentGroup(w, r)
+	if !ok {
+		return
+	}
+	writeJSON(w, http.StatusOK, group)
+}
+
+// componentGroupBody is the request body of component group creates and
+// updates. Statuspage reads the description at the top level, but a
+// description inside the component group is taken if there is none.
+type componentGroupBody struct {
+	Description    string `json:"description"`
+	ComponentGroup struct {
+		Name        string   `json:"name"`
+		Description string   `json:"description"`
+		Components  []string `json:"components"`
+	} `json:"component_group"`
+}
+
+func (b *componentGroupBody) description() string {
+	if b.Description != "" {
+		return b.Description
+	}
+	return b.ComponentGroup.Description
+}
+
+func (s *Server) createComponentGroup(w http.ResponseWriter, r *http.Request) {
+	var body componentGroupBody
+	if !decode(w, r, &body) {
+		return
+	}
+	params := body.ComponentGroup
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	p, ok := s.findPage(w, r)
+	if !ok {
+		return
+	}
+	if errs := validateComponentGroup(p, params.Name, params.Components); len(errs) > 0 {
+		writeErrors(w, http.StatusUnprocessableEntity, errs)
+		return
+	}
+
+	position := int32(len(p.groups) + 1)
+	createdAt := now()
+	group := &statuspage.ComponentGroup{
+		ID:        newID(),
+		PageID:    p.page.ID,
+		Name:      stringPtr(params.Name),
+		Position:  &position,
+		CreatedAt: createdAt,
+		UpdatedAt: createdAt,
+	}
+	setString(&group.Description, body.description())
+	p.groups = append(p.groups, group)
+	p.setGroupComponents(group, params.Components)
+
+	writeJSON(w, http.StatusCreated, group)
+}
+
+func (s *Server) updateComponentGroup(w http.ResponseWriter, r *http.Request) {
+	var body componentGroupBody
+	if !decode(w, r, &body) {
+		return
+	}
+	params := body.ComponentGroup
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	p, group, ok := s.findComponentGroup(w, r)
+	if !ok {
+		return
+	}
+	name := params.Name
+	if name == "" {
+		name = *group.Name
+	}
+	if errs := validateComponentGroup(p, name, params.Components); len(errs) > 0 {
+		writeErrors(w, http.StatusUnprocessableEntity, errs)
+		return
+	}
+
+	group.Name = stringPtr(name)
+	setString(&group.Description, body.description())
+	p.setGroupComponents(group, params.Components)
+	group.UpdatedAt = now()
+
+	writeJSON(w, http.StatusOK, group)
+}
+
+func (s *Server) deleteComponentGroup(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	p, group, ok := s.findComponentGroup(w, r)
+	if !ok {
+		return
+	}
+	p.setGroupComponents(group, nil)
+	p.groups = slices.DeleteFunc(p.groups, func(g *statuspage.ComponentGroup) bool { return g == group })
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// validateComponentGroup returns the validation errors of a component group
+// with the given name and components, which must exist on the page.
+func validateComponentGroup(p *pageState, name string, components []string) []string {
+	var errs []string
+	if name == "" {
+		errs = append(errs, "Name can't be blank")
+	}
+	if len(components) == 0 {
+		errs = append(errs, "Components can't be blank")
+	}
+	for _, id := range components {
+		if p.component(id) == nil {
+			errs = append(errs, "Component "+id+" not found")
+		}
+	}
+	return errs
+}
+
+func (s *Server) listIncidents(match func(*statuspage.Incident) bool) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		p, ok := s.findPage(w, r)
+		if !ok {
+			return
+		}
+
+		// Statuspage lists the most recent incidents first.
+		incidents := []statuspage.Incident{}
+		for i := len(p.incidents) - 1; i >= 0; i-- {
+			if match(p.incidents[i]) {
+				incidents = append(incidents, *p.incidents[i])
+			}
+		}
+		writeJSON(w, http.StatusOK, paginate(r, incidents))
+	}
+}
+
+func hasStatus(statuses ...statuspage.IncidentStatus) func(*statuspage.Incident) bool {
+	return func(i *statuspage.Incident) bool {
+		return i.Status != nil && slices.Contains(statuses, statuspage.IncidentStatus(*i.Status))
+	}
+}
+
+func (s *Server) getIncident(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	_, incident, ok := s.findIncident(w, r)
+	if !ok {
+		return
+	}
+	writeJSON(w, http.StatusOK, incident)
+}
+
+func (s *Server) createIncident(w http.ResponseWriter, r *http.Request) {
+	var body statuspage.CreateIncidentRequestBody
+	if !decode(w, r, &body) {
+		return
+	}
+	params := body.Incident
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	p, ok := s.findPage(w, r)
+	if !ok {
+		return
+	}
+
+	errs := validateIncident(p, params)
+	if params.Name == "" {
+		errs = append([]string{"Name can't be blank"}, errs...)
+	}
+	if len(errs) > 0 {
+		writeErrors(w, http.StatusUnprocessableEntity, errs)
+		return
+	}
+
+	createdAt := now()
+	incident := &statuspage.Incident{
+		ID:        newID(),
+		PageID:    p.page.ID,
+		Impact:    stringPtr(string(statuspage.IncidentImpactNone)),
+		CreatedAt: createdAt,
+		Shortlink: stringPtr("https://stspg.io/" + *newID()),
+	}
+	if params.Status == "" {
+		params.Status = statuspage.IncidentStatusInvestigating
+		if params.ScheduledFor != nil {
+			params.Status = statuspage.IncidentStatusScheduled
+		}
+	}
+	applyIncident(p, incident, params)
+	p.incidents = append(p.incidents, incident)
+
+	writeJSON(w, http.StatusCreated, incident)
+}
+
+func (s *Server) updateIncident(w http.ResponseWriter, r *http.Request) {
+	var body statuspage.UpdateIncidentRequestBody
+	if !decode(w, r, &body) {
+		return
+	}
+	params := statuspage.CreateIncidentParams(body.Incident)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	p, incident, ok := s.findIncident(w, r)
+	if !ok {
+		return
+	}
+
+	if errs := validateIncident(p, params); len(errs) > 0 {
+		writeErrors(w, http.StatusUnprocessableEntity, errs)
+		return
+	}
+	applyIncident(p, incident, params)
+
+	writeJSON(w, http.StatusOK, incident)
+}
+
+func (s *Server) deleteIncident(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	p, incident, ok := s.findIncident(w, r)
+	if !ok {
+		return
+	}
+	p.incidents = slices.DeleteFunc(p.incidents, func(i *statuspage.Incident) bool { return i == incident })
+
+	writeJSON(w, http.StatusOK, incident)
+}
+
+// validateIncident returns the validation errors of an incident create or
+// update request for page p.
+func validateIncident(p *pageState, params statuspage.CreateIncidentParams) []string {
+	var errs []string
+	if params.Status != "" && !params.Status.Valid() {
+		errs = append(errs, "Status is not included in the list")
+	}
+	if params.ImpactOverride != "" && !params.ImpactOverride.Valid() {
+		errs = append(errs, "Impact override is not included in the list")
+	}
+	for id, status := range params.Components {
+		if !status.Valid() {
+			errs = append(errs, "Component status "+string(status)+" is not included in the list")
+		}
+		if p.component(id) == nil {
+			errs = append(errs, "Component "+id+" not found")
+		}
+	}
+	for _, id := range params.ComponentIDs {
+		if p.component(id) == nil {
+			errs = append(errs, "Component "+id+" not found")
+		}
+	}
+	if params.ScheduledFor != nil && params.ScheduledUntil != nil && !params.ScheduledUntil.After(params.ScheduledFor.Time) {
+		errs = append(errs, "Scheduled until must be after scheduled for")
+	}
+	return errs
+}
+
+// applyIncident applies a validated incident create or update request to
+// incident, updating the affected components of page p along the way.
+func applyIncident(p *pageState, incident *statuspage.Incident, params statuspage.CreateIncidentParams) {
+	updatedAt := now()
+	incident.UpdatedAt = updatedAt
+
+	setString(&incident.Name, params.Name)
+	if params.ImpactOverride != "" {
+		incident.ImpactOverride = stringPtr(string(params.ImpactOverride))
+		incident.Impact = incident.ImpactOverride
+	}
+	if params.ScheduledFor != nil {
+		incident.ScheduledFor = params.ScheduledFor
+	}
+	if params.ScheduledUntil != nil {
+		incident.ScheduledUntil = params.ScheduledUntil
+	}
+	setBool(&incident.ScheduledRemindPrior, params.ScheduledRemindPrior)
+	setBool(&incident.ScheduledAutoInProgress, params.ScheduledAutoInProgress)
+	setBool(&incident.ScheduledAutoCompleted, params.ScheduledAutoCompleted)
+	setBool(&incident.AutoTransitionToMaintenanceState, params.AutoTransitionToMaintenanceState)
+	setBool(&incident.AutoTransitionToOperationalState, params.AutoTransitionToOperationalState)
+	setBool(&incident.AutoTransitionDeliverNotificationsAtStart, params.AutoTransitionDeliverNotificationsAtStart)
+	setBool(&incident.AutoTransitionDeliverNotificationsAtEnd, params.AutoTransitionDeliverNotificationsAtEnd)
+	setString(&incident.ReminderIntervals, params.ReminderIntervals)
+
+	statusChanged := params.Status != "" && (incident.Status == nil || *incident.Status != string(params.Status))
+	if statusChanged {
+		incident.Status = stringPtr(string(params.Status))
+		switch params.Status {
+		case statuspage.IncidentStatusMonitoring:
+			incident.MonitoringAt = updatedAt
+		case statuspage.IncidentStatusResolved, statuspage.IncidentStatusCompleted:
+			incident.ResolvedAt = updatedAt
+		}
+	}
+
+	var affected []statuspage.AffectedComponent
+	ids := slices.Clone(params.ComponentIDs)
+	for id := range params.Components {
+		if !slices.Contains(ids, id) {
+			ids = append(ids, id)
+		}
+	}
+	slices.Sort(ids)
+	for _, id := range ids {
+		component := p.component(id)
+		if status, ok := params.Components[id]; ok && *component.Status != string(status) {
+			affected = append(affected, statuspage.AffectedComponent{
+				Code:      component.ID,
+				Name:      component.Name,
+				OldStatus: stringPtr(*component.Status),
+				NewStatus: stringPtr(string(status)),
+			})
+			component.Status = stringPtr(string(status))
+			component.UpdatedAt = updatedAt
+		}
+		incident.Components = slices.DeleteFunc(incident.Components, func(c statuspage.Component) bool {
+			return *c.ID == id
+		})
+		incident.Components = append(incident.Components, *component)
+	}
+
+	if statusChanged || params.Body != "" || len(affected) > 0 {
+		update := statuspage.IncidentUpdate{
+			ID:                   newID(),
+			IncidentID:           incident.ID,
+			AffectedComponents:   affected,
+			CreatedAt:            updatedAt,
+			UpdatedAt:            updatedAt,
+			DisplayAt:            updatedAt,
+			Status:               incident.Status,
+			DeliverNotifications: params.DeliverNotifications,
+		}
+		setString(&update.Body, params.Body)
+		// Statuspage lists the most recent update first.
+		incident.IncidentUpdates = append([]statuspage.IncidentUpdate{update}, incident.IncidentUpdates...)
+	}
+}
+
+func (s *Server) listSubscribers(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	p, ok := s.findPage(w, r)
+	if !ok {
+		return
+	}
+
+	q := r.URL.Query().Get("q")
+	mode := r.URL.Query().Get("type")
+	state := statuspage.SubscriberState(r.URL.Query().Get("state"))
+	switch state {
+	case "":
+		state = statuspage.SubscriberStateActive
+	case statuspage.SubscriberStateActive, statuspage.SubscriberStateUnconfirmed,
+		statuspage.SubscriberStateQuarantined, statuspage.SubscriberStateAll:
+	default:
+		writeError(w, http.StatusBadRequest, "Invalid state "+string(state))
+		return
+	}
+	subscribers := []statuspage.Subscriber{}
+	for _, subscriber := range p.subscribers {
+		if mode != "" && (subscriber.Mode == nil || *subscriber.Mode != mode) {
+			continue
+		}
+		if state != statuspage.SubscriberStateAll && subscriberState(subscriber) != state {
+			continue
+		}
+		if q != "" && !subscriberMatches(subscriber, q) {
+			continue
+		}
+		subscribers = append(subscribers, *subscriber)
+	}
+	writeJSON(w, http.StatusOK, paginate(r, subscribers))
+}
+
+// subscriberState returns the state of subscriber. As the fake sends no
+// confirmations, subscribers are active unless they are quarantined or were
+// created with SkipConfirmationNotification set to false.
+func subscriberState(subscriber *statuspage.Subscriber) statuspage.SubscriberState {
+	switch {
+	case subscriber.QuarantinedAt != nil:
+		return statuspage.SubscriberStateQuarantined
+	case subscriber.SkipConfirmationNotification != nil && !*subscriber.SkipConfirmationNotification:
+		return statuspage.SubscriberStateUnconfirmed
+	}
+	return statuspage.SubscriberStateActive
+}
+
+func subscriberMatches(subscriber *statuspage.Subscriber, q string) bool {
+	for _, field := range []*string{subscriber.Email, subscriber.PhoneNumber, subscriber.Endpoint} {
+		if field != nil && strings.Contains(*field, q) {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *Server) getSubscriber(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	_, subscriber, ok := s.findSubscriber(w, r)
+	if !ok {
+		return
+	}
+	writeJSON(w, http.StatusOK, subscriber)
+}
+
+func (s *Server) createSubscriber(w http.ResponseWriter, r *http.Request) {
+	var body statuspage.CreateSubscriberRequestBody
+	if !decode(w, r, &body) {
+		return
+	}
+	params := body.Subscriber
+
+	var mode statuspage.SubscriberType
+	switch {
+	case params.Email != "":
+		mode = statuspage.SubscriberTypeEmail
+	case params.PhoneNumber != "":
+		mode = statuspage.SubscriberTypeSMS
+	case params.Endpoint != "":
+		mode = statuspage.SubscriberTypeWebhook
+	default:
+		writeErrors(w, http.StatusUnprocessableEntity, []string{"Email, phone number or endpoint is required"})
+		return
+	}
+	if params.Endpoint != "" && params.Email == "" {
+		// Webhook subscribers need an email address for failure notices.
+		writeErrors(w, http.StatusUnprocessableEntity, []string{"Email can't be blank"})
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	p, ok := s.findPage(w, r)
+	if !ok {
+		return
+	}
+	for _, id := range params.ComponentIDs {
+		if p.component(id) == nil {
+			writeErrors(w, http.StatusUnprocessableEntity, []string{"Component " + id + " not found"})
+			return
+		}
+	}
+
+	subscriber := &statuspage.Subscriber{
+		ID:                           newID(),
+		Mode:                         stringPtr(string(mode)),
+		SkipConfirmationNotification: params.SkipConfirmationNotification,
+		Components:                   params.ComponentIDs,
+		CreatedAt:                    now(),
+	}
+	setString(&subscriber.Email, params.Email)
+	setString(&subscriber.Endpoint, params.Endpoint)
+	setString(&subscriber.PhoneNumber, params.PhoneNumber)
+	setString(&subscriber.PhoneCountry, params.PhoneCountry)
+	setString(&subscriber.PageAccessUserID, params.PageAccessUser)
+	p.subscribers = append(p.subscribers, subscriber)
+
+	writeJSON(w, http.StatusCreated, subscriber)
+}
+
+func (s *Server) updateSubscriber(w http.ResponseWriter, r *http.Request) {
+	var params statuspage.UpdateSubscriberParams
+	if !decode(w, r, &params) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	p, subscriber, ok := s.findSubscriber(w, r)
+	if !ok {
+		return
+	}
+	for _, id := range params.ComponentIDs {
+		if p.component(id) == nil {
+			writeErrors(w, http.StatusUnprocessableEntity, []string{"Component " + id + " not found"})
+			return
+		}
+	}
+	subscriber.Components = params.ComponentIDs
+
+	writeJSON(w, http.StatusOK, subscriber)
+}
+
+func (s *Server) deleteSubscriber(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	p, subscriber, ok := s.findSubscriber(w, r)
+	if !ok {
+		return
+	}
+	p.subscribers = slices.DeleteFunc(p.subscribers, func(sub *statuspage.Subscriber) bool { return sub == subscriber })
+
+	writeJSON(w, http.StatusOK, subscriber)
+}
+
+// findPage returns the page of the request, or writes a 404 response and
+// returns false. s.mu must be held.
+func (s *Server) findPage(w http.ResponseWriter, r *http.Request) (*pageState, bool) {
+	p := s.page(r.PathValue("page"))
+	if p == nil {
+		writeError(w, http.StatusNotFound, "Page not found")
+		return nil, false
+	}
+	return p, true
+}
+
+func (s *Server) findComponent(w http.ResponseWriter, r *http.Request) (*pageState, *statuspage.Component, bool) {
+	p, ok := s.findPage(w, r)
+	if !ok {
+		return nil, nil, false
+	}
+	component := p.component(r.PathValue("component"))
+	if component == nil {
+		writeError(w, http.StatusNotFound, "Component not found")
+		return nil, nil, false
+	}
+	return p, component, true
+}
+
+func (s *Server) findComponentGroup(w http.ResponseWriter, r *http.Request) (*pageState, *statuspage.ComponentGroup, bool) {
+	p, ok := s.findPage(w, r)
+	if !ok {
+		return nil, nil, false
+	}
+	id := r.PathValue("group")
+	for _, group := range p.groups {
+		if *group.ID == id {
+			return p, group, true
+		}
+	}
+	writeError(w, http.StatusNotFound, "Component group not found")
+	return nil, nil, false
+}
+
+func (s *Server) findIncident(w http.ResponseWriter, r *http.Request) (*pageState, *statuspage.Incident, bool) {
+	p, ok := s.findPage(w, r)
+	if !ok {
+		return nil, nil, false
+	}
+	id := r.PathValue("incident")
+	for _, incident := range p.incidents {
+		if *incident.ID == id {
+			return p, incident, true
+		}
+	}
+	writeError(w, http.StatusNotFound, "Incident not found")
+	return nil, nil, false
+}
+
+func (s *Server) findSubscriber(w http.ResponseWriter, r *http.Request) (*pageState, *statuspage.Subscriber, bool) {
+	p, ok := s.findPage(w, r)
+	if !ok {
+		return nil, nil, false
+	}
+	id := r.PathValue("subscriber")
+	for _, subscriber := range p.subscribers {
+		if *subscriber.ID == id {
+			return p, subscriber, true
+		}
+	}
+	writeError(w, http.StatusNotFound, "Subscriber not found")
+	return nil, nil, false
+}
+
+func (p *pageState) component(id string) *statuspage.Component {
+	for _, component := range p.components {
+		if *component.ID == id {
+			return component
+		}
+	}
+	return nil
+}
+
+// setGroupComponents makes the components with the given ids the components
+// of group, moving them out of other groups and ungrouping the components
+// the group no longer has.
+func (p *pageState) setGroupComponents(group *statuspage.ComponentGroup, ids []string) {
+	for _, component := range p.components {
+		if component.GroupID != nil && *component.GroupID == *group.ID {
+			component.GroupID = nil
+		}
+	}
+	for _, g := range p.groups {
+		g.Components = slices.DeleteFunc(g.Components, func(id string) bool { return slices.Contains(ids, id) })
+	}
+	group.Components = slices.Clone(ids)
+	for _, id := range ids {
+		if component := p.component(id); component != nil {
+			component.GroupID = group.ID
+		}
+	}
+}
+
+// moveToGroup adds component to the group with the given id, if there is
+// one.
+func (p *pageState) moveToGroup(component *statuspage.Component, groupID string) {
+	for _, group := range p.groups {
+		if *group.ID == groupID && !slices.Contains(group.Components, *component.ID) {
+			p.setGroupComponents(group, append(slices.Clone(group.Components), *component.ID))
+		}
+	}
+}
+
+// paginate returns the page of items selected by the page and per_page query
+// parameters of r.
+func paginate[T any](r *http.Request, items []T) []T {
+	page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+	if page < 1 {
+		page = 1
+	}
+	perPage, _ := strconv.Atoi(r.URL.Query().Get("per_page"))
+	if perPage < 1 {
+		perPage = defaultPerPage
+	}
+
+	start := (page - 1) * perPage
+	if start >= len(items) {
+		return []T{}
+	}
+	return items[start:min(start+perPage, len(items))]
+}
+
+func values[T any](items []*T) []T {
+	v := make([]T, len(items))
+	for i, item := range items {
+		v[i] = *item
+	}
+	return v
+}
+
+// decode decodes the JSON request body into v, or writes a 400 response and
+// returns false.
+func decode(w http.ResponseWriter, r *http.Request, v interface{}) bool {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		writeError(w, http.StatusBadRequest, "Invalid JSON: "+err.Error())
+		return false
+	}
+	return true
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+// writeError writes an error body with a single message, the way
+// Statuspage reports most errors.
+func writeError(w http.ResponseWriter, status int, message string) {
+	writeJSON(w, status, map[string]string{"error": message})
+}
+
+// writeErrors writes an error body with a list of validation messages.
+func writeErrors(w http.ResponseWriter, status int, messages []string) {
+	writeJSON(w, status, map[string][]string{"error": messages})
+}
+
+func setString(dst **string, value string) {
+	if value != "" {
+		*dst = &value
+	}
+}
+
+func setBool(dst **bool, value *bool) {
+	if value != nil {
+		v := *value
+		*dst = &v
+	}
+}
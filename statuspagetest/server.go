@@ -0,0 +1,296 @@
+// Package statuspagetest provides an in-memory fake of the Statuspage API for
+// tests of code built on the statuspage package.
+//
+// The fake keeps pages, components, component groups, incidents and
+// subscribers in memory, serves them on the same REST paths as the
+// Statuspage API, validates requests the way the API does and answers
+// errors with Statuspage style error bodies. Latency and error responses
+// such as 429 Too Many Requests can be injected to exercise retries and
+// timeouts.
+package statuspagetest
+
+import (
+	"math/rand/v2"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/nagelflorian/statuspage-go"
+)
+
+// DefaultToken is the API token a Server accepts unless SetToken is called.
+const DefaultToken = "statuspagetest-token"
+
+// Server is a fake Statuspage API server. It is safe for concurrent use by
+// multiple goroutines.
+type Server struct {
+	// Server is the underlying test server. Its URL is the base URL of the
+	// fake API.
+	*httptest.Server
+
+	mu          sync.Mutex
+	token       string
+	pages       []*pageState
+	latency     time.Duration
+	faults      []Fault
+	requests    int
+	rateLimit   int
+	rateUsed    int
+	rateResetAt time.Time
+}
+
+// pageState is a page and the resources that belong to it.
+type pageState struct {
+	page        statuspage.Page
+	components  []*statuspage.Component
+	groups      []*statuspage.ComponentGroup
+	incidents   []*statuspage.Incident
+	subscribers []*statuspage.Subscriber
+}
+
+// Fault describes an error response the Server sends instead of handling a
+// request.
+type Fault struct {
+	// StatusCode is the status of the error response, e.g. 429 or 503.
+	StatusCode int
+
+	// RetryAfter, if positive, is sent in the Retry-After header, rounded
+	// down to whole seconds.
+	RetryAfter time.Duration
+
+	// Times is how many requests the fault applies to. Defaults to one.
+	Times int
+}
+
+// NewServer starts and returns a new Server without any pages. The caller
+// should call Close when finished, to shut it down.
+func NewServer() *Server {
+	s := &Server{token: DefaultToken, rateLimit: 3600}
+	s.Server = httptest.NewServer(s.routes())
+	return s
+}
+
+// SetToken changes the API token the server accepts.
+func (s *Server) SetToken(token string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.token = token
+}
+
+// Client returns a statuspage.Client configured to talk to s with the token
+// it accepts. Further options are applied after the base URL is set.
+func (s *Server) Client(opts ...statuspage.Option) *statuspage.Client {
+	s.mu.Lock()
+	token := s.token
+	s.mu.Unlock()
+
+	u, _ := url.Parse(s.URL)
+	return statuspage.NewClient(token, append([]statuspage.Option{statuspage.WithBaseURL(u)}, opts...)...)
+}
+
+// SetLatency delays every response by d. A zero d removes the delay.
+func (s *Server) SetLatency(d time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.latency = d
+}
+
+// InjectFault queues f. Queued faults are applied to the next requests in the
+// order they were injected.
+func (s *Server) InjectFault(f Fault) {
+	if f.Times <= 0 {
+		f.Times = 1
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.faults = append(s.faults, f)
+}
+
+// Requests returns the number of requests the server received so far,
+// including requests answered with an injected fault.
+func (s *Server) Requests() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.requests
+}
+
+// AddPage stores page and returns its ID. An ID is generated if page has
+// none.
+func (s *Server) AddPage(page statuspage.Page) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if page.ID == nil {
+		page.ID = newID()
+	}
+	if page.CreatedAt == nil {
+		page.CreatedAt = now()
+		page.UpdatedAt = page.CreatedAt
+	}
+	s.pages = append(s.pages, &pageState{page: page})
+	return *page.ID
+}
+
+// AddComponent stores component on the page with the given ID and returns the
+// component's ID. An ID is generated if component has none. It panics if the
+// page does not exist.
+func (s *Server) AddComponent(pageID string, component statuspage.Component) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	p := s.mustPage(pageID)
+	if component.ID == nil {
+		component.ID = newID()
+	}
+	component.PageID = &pageID
+	if component.Status == nil {
+		component.Status = stringPtr(string(statuspage.ComponentStatusOperational))
+	}
+	p.components = append(p.components, &component)
+	return *component.ID
+}
+
+// AddComponentGroup stores group on the page with the given ID and returns
+// the group's ID. An ID is generated if group has none. The group's
+// components are moved into it. It panics if the page does not exist.
+func (s *Server) AddComponentGroup(pageID string, group statuspage.ComponentGroup) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	p := s.mustPage(pageID)
+	if group.ID == nil {
+		group.ID = newID()
+	}
+	group.PageID = &pageID
+	p.groups = append(p.groups, &group)
+	p.setGroupComponents(&group, group.Components)
+	return *group.ID
+}
+
+// AddIncident stores incident on the page with the given ID and returns the
+// incident's ID. An ID is generated if incident has none. It panics if the
+// page does not exist.
+func (s *Server) AddIncident(pageID string, incident statuspage.Incident) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	p := s.mustPage(pageID)
+	if incident.ID == nil {
+		incident.ID = newID()
+	}
+	incident.PageID = &pageID
+	p.incidents = append(p.incidents, &incident)
+	return *incident.ID
+}
+
+// AddSubscriber stores subscriber on the page with the given ID and returns
+// the subscriber's ID. An ID is generated if subscriber has none. It panics if
+// the page does not exist.
+//
+// Listing subscribers filters them by state like the API, deriving the state
+// from the subscriber: it is quarantined if QuarantinedAt is set, unconfirmed
+// if SkipConfirmationNotification is false and active otherwise.
+func (s *Server) AddSubscriber(pageID string, subscriber statuspage.Subscriber) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	p := s.mustPage(pageID)
+	if subscriber.ID == nil {
+		subscriber.ID = newID()
+	}
+	p.subscribers = append(p.subscribers, &subscriber)
+	return *subscriber.ID
+}
+
+func (s *Server) mustPage(pageID string) *pageState {
+	p := s.page(pageID)
+	if p == nil {
+		panic("statuspagetest: unknown page " + pageID)
+	}
+	return p
+}
+
+// page returns the page with the given ID or nil. s.mu must be held.
+func (s *Server) page(pageID string) *pageState {
+	for _, p := range s.pages {
+		if *p.page.ID == pageID {
+			return p
+		}
+	}
+	return nil
+}
+
+// intercept counts the request, applies latency and authentication, and
+// sends an injected fault if one is queued. It reports whether the request
+// was answered.
+func (s *Server) intercept(w http.ResponseWriter, r *http.Request) bool {
+	s.mu.Lock()
+	s.requests++
+	latency := s.latency
+	token := s.token
+	var fault *Fault
+	if len(s.faults) > 0 {
+		f := s.faults[0]
+		fault = &f
+		if s.faults[0].Times--; s.faults[0].Times == 0 {
+			s.faults = s.faults[1:]
+		}
+	}
+	if time.Now().After(s.rateResetAt) {
+		s.rateResetAt = time.Now().Add(time.Hour).Truncate(time.Second)
+		s.rateUsed = 0
+	}
+	s.rateUsed++
+	w.Header().Set("X-RateLimit-Limit", strconv.Itoa(s.rateLimit))
+	w.Header().Set("X-RateLimit-Remaining", strconv.Itoa(max(s.rateLimit-s.rateUsed, 0)))
+	w.Header().Set("X-RateLimit-Reset", strconv.FormatInt(s.rateResetAt.Unix(), 10))
+	s.mu.Unlock()
+
+	if latency > 0 {
+		timer := time.NewTimer(latency)
+		defer timer.Stop()
+		select {
+		case <-r.Context().Done():
+			return true
+		case <-timer.C:
+		}
+	}
+
+	if fault != nil {
+		if fault.RetryAfter > 0 {
+			w.Header().Set("Retry-After", strconv.Itoa(int(fault.RetryAfter/time.Second)))
+		}
+		writeError(w, fault.StatusCode, http.StatusText(fault.StatusCode))
+		return true
+	}
+
+	if r.Header.Get("Authorization") != "OAuth "+token {
+		writeError(w, http.StatusUnauthorized, "Could not authenticate")
+		return true
+	}
+
+	return false
+}
+
+const idAlphabet = "abcdefghijklmnopqrstuvwxyz0123456789"
+
+// newID returns a random ID shaped like the ones Statuspage hands out.
+func newID() *string {
+	b := make([]byte, 12)
+	for i := range b {
+		b[i] = idAlphabet[rand.IntN(len(idAlphabet))]
+	}
+	id := string(b)
+	return &id
+}
+
+func now() *statuspage.Timestamp {
+	return &statuspage.Timestamp{Time: time.Now().UTC().Truncate(time.Second)}
+}
+
+func stringPtr(s string) *string {
+	return &s
+}
@@ -0,0 +1,318 @@
+package statuspagetest
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/nagelflorian/statuspage-go"
+)
+
+func newTestServer(t *testing.T) (*Server, string) {
+	t.Helper()
+	s := NewServer()
+	t.Cleanup(s.Close)
+	name := "Example"
+	return s, s.AddPage(statuspage.Page{Name: &name})
+}
+
+func TestServer_components(t *testing.T) {
+	s, pageID := newTestServer(t)
+	client := s.Client()
+	ctx := context.Background()
+
+	created, _, err := client.Component.CreateComponent(ctx, pageID, statuspage.CreateComponentParams{
+		Name:   "API",
+		Status: statuspage.ComponentStatusDegradedPerformance,
+	})
+	if err != nil {
+		t.Fatalf("ComponentService.CreateComponent returned error: %v", err)
+	}
+
+	updated, _, err := client.Component.UpdateComponent(ctx, pageID, *created.ID, statuspage.UpdateComponentParams{
+		Status: statuspage.ComponentStatusOperational,
+	})
+	if err != nil {
+		t.Fatalf("ComponentService.UpdateComponent returned error: %v", err)
+	}
+	if *updated.Status != "operational" || *updated.Name != "API" {
+		t.Errorf("ComponentService.UpdateComponent returned %+v", updated)
+	}
+
+	components, _, err := client.Component.ListComponents(ctx, pageID, nil)
+	if err != nil {
+		t.Fatalf("ComponentService.ListComponents returned error: %v", err)
+	}
+	if len(*components) != 1 || *(*components)[0].ID != *created.ID {
+		t.Errorf("ComponentService.ListComponents returned %+v", components)
+	}
+
+	if _, err := client.Component.DeleteComponent(ctx, pageID, *created.ID); err != nil {
+		t.Fatalf("ComponentService.DeleteComponent returned error: %v", err)
+	}
+	_, _, err = client.Component.GetComponent(ctx, pageID, *created.ID)
+	if !statuspage.IsNotFound(err) {
+		t.Errorf("ComponentService.GetComponent returned error %v, want not found", err)
+	}
+}
+
+func TestServer_componentGroups(t *testing.T) {
+	s, pageID := newTestServer(t)
+	client := s.Client()
+	ctx := context.Background()
+	api := s.AddComponent(pageID, statuspage.Component{})
+	database := s.AddComponent(pageID, statuspage.Component{})
+
+	created, _, err := client.ComponentGroup.CreateComponentGroup(ctx, pageID, statuspage.CreateComponentGroupParams{
+		Name:        "Backend",
+		Description: "Servers",
+		Components:  []string{api, database},
+	})
+	if err != nil {
+		t.Fatalf("ComponentGroupService.CreateComponentGroup returned error: %v", err)
+	}
+	if created.Description == nil || *created.Description != "Servers" {
+		t.Errorf("ComponentGroupService.CreateComponentGroup returned description %v, want Servers", created.Description)
+	}
+	component, _, err := client.Component.GetComponent(ctx, pageID, api)
+	if err != nil || component.GroupID == nil || *component.GroupID != *created.ID {
+		t.Errorf("ComponentService.GetComponent returned %+v, %v, want component in group %s", component, err, *created.ID)
+	}
+
+	updated, _, err := client.ComponentGroup.UpdateComponentGroup(ctx, pageID, *created.ID, statuspage.UpdateComponentGroupParams{
+		Description: "Databases",
+		Components:  []string{database},
+	})
+	if err != nil {
+		t.Fatalf("ComponentGroupService.UpdateComponentGroup returned error: %v", err)
+	}
+	if *updated.Name != "Backend" || *updated.Description != "Databases" || !reflect.DeepEqual(updated.Components, []string{database}) {
+		t.Errorf("ComponentGroupService.UpdateComponentGroup returned %+v", updated)
+	}
+	component, _, _ = client.Component.GetComponent(ctx, pageID, api)
+	if component.GroupID != nil {
+		t.Errorf("ComponentService.GetComponent returned group %s, want none", *component.GroupID)
+	}
+
+	if _, err := client.ComponentGroup.DeleteComponentGroup(ctx, pageID, *created.ID); err != nil {
+		t.Fatalf("ComponentGroupService.DeleteComponentGroup returned error: %v", err)
+	}
+	_, _, err = client.ComponentGroup.GetComponentGroup(ctx, pageID, *created.ID)
+	if !statuspage.IsNotFound(err) {
+		t.Errorf("ComponentGroupService.GetComponentGroup returned error %v, want not found", err)
+	}
+
+	_, _, err = client.ComponentGroup.CreateComponentGroup(ctx, pageID, statuspage.CreateComponentGroupParams{Name: "Empty"})
+	var errResp *statuspage.ErrorResponse
+	if !errors.As(err, &errResp) || errResp.StatusCode != http.StatusUnprocessableEntity {
+		t.Errorf("ComponentGroupService.CreateComponentGroup without components returned error %v, want 422", err)
+	}
+}
+
+func TestServer_incidents(t *testing.T) {
+	s, pageID := newTestServer(t)
+	componentID := s.AddComponent(pageID, statuspage.Component{})
+	client := s.Client()
+	ctx := context.Background()
+
+	incident, _, err := client.Incident.CreateIncident(ctx, pageID, statuspage.CreateIncidentParams{
+		Name:       "Database outage",
+		Body:       "We are investigating.",
+		Components: map[string]statuspage.ComponentStatus{componentID: statuspage.ComponentStatusMajorOutage},
+	})
+	if err != nil {
+		t.Fatalf("IncidentService.CreateIncident returned error: %v", err)
+	}
+	if *incident.Status != "investigating" || len(incident.IncidentUpdates) != 1 || len(incident.Components) != 1 {
+		t.Errorf("IncidentService.CreateIncident returned %+v", incident)
+	}
+
+	component, _, err := client.Component.GetComponent(ctx, pageID, componentID)
+	if err != nil {
+		t.Fatalf("ComponentService.GetComponent returned error: %v", err)
+	}
+	if *component.Status != "major_outage" {
+		t.Errorf("Component status is %q, want %q", *component.Status, "major_outage")
+	}
+
+	unresolved, _, err := client.Incident.ListUnresolvedIncidents(ctx, pageID, nil)
+	if err != nil {
+		t.Fatalf("IncidentService.ListUnresolvedIncidents returned error: %v", err)
+	}
+	if len(*unresolved) != 1 {
+		t.Errorf("IncidentService.ListUnresolvedIncidents returned %d incidents, want 1", len(*unresolved))
+	}
+
+	resolved, _, err := client.Incident.ResolveIncident(ctx, pageID, *incident.ID, "Fixed.")
+	if err != nil {
+		t.Fatalf("IncidentService.ResolveIncident returned error: %v", err)
+	}
+	if *resolved.Status != "resolved" || resolved.ResolvedAt == nil || len(resolved.IncidentUpdates) != 2 {
+		t.Errorf("IncidentService.ResolveIncident returned %+v", resolved)
+	}
+
+	unresolved, _, err = client.Incident.ListUnresolvedIncidents(ctx, pageID, nil)
+	if err != nil {
+		t.Fatalf("IncidentService.ListUnresolvedIncidents returned error: %v", err)
+	}
+	if len(*unresolved) != 0 {
+		t.Errorf("IncidentService.ListUnresolvedIncidents returned %d incidents, want 0", len(*unresolved))
+	}
+}
+
+func TestServer_subscribers(t *testing.T) {
+	s, pageID := newTestServer(t)
+	client := s.Client()
+	ctx := context.Background()
+
+	subscriber, _, err := client.Subscriber.CreateSubscriber(ctx, pageID, statuspage.CreateSubscriberParams{
+		Email: "ops@example.com",
+	})
+	if err != nil {
+		t.Fatalf("SubscriberService.CreateSubscriber returned error: %v", err)
+	}
+	if *subscriber.Mode != "email" {
+		t.Errorf("SubscriberService.CreateSubscriber returned mode %q, want %q", *subscriber.Mode, "email")
+	}
+
+	subscribers, _, err := client.Subscriber.ListSubscribers(ctx, pageID, &statuspage.SubscriberListOptions{Q: "ops@"})
+	if err != nil {
+		t.Fatalf("SubscriberService.ListSubscribers returned error: %v", err)
+	}
+	if len(*subscribers) != 1 {
+		t.Errorf("SubscriberService.ListSubscribers returned %d subscribers, want 1", len(*subscribers))
+	}
+
+	if _, _, err := client.Subscriber.UnsubscribeSubscriber(ctx, pageID, *subscriber.ID); err != nil {
+		t.Fatalf("SubscriberService.UnsubscribeSubscriber returned error: %v", err)
+	}
+	_, _, err = client.Subscriber.GetSubscriber(ctx, pageID, *subscriber.ID)
+	if !statuspage.IsNotFound(err) {
+		t.Errorf("SubscriberService.GetSubscriber returned error %v, want not found", err)
+	}
+}
+
+func TestServer_subscribers_state(t *testing.T) {
+	s, pageID := newTestServer(t)
+	client := s.Client()
+	ctx := context.Background()
+	skip := false
+	active := s.AddSubscriber(pageID, statuspage.Subscriber{})
+	unconfirmed := s.AddSubscriber(pageID, statuspage.Subscriber{SkipConfirmationNotification: &skip})
+	quarantined := s.AddSubscriber(pageID, statuspage.Subscriber{QuarantinedAt: &statuspage.Timestamp{Time: time.Now()}})
+
+	tests := []struct {
+		state statuspage.SubscriberState
+		want  []string
+	}{
+		{"", []string{active}},
+		{statuspage.SubscriberStateActive, []string{active}},
+		{statuspage.SubscriberStateUnconfirmed, []string{unconfirmed}},
+		{statuspage.SubscriberStateQuarantined, []string{quarantined}},
+		{statuspage.SubscriberStateAll, []string{active, unconfirmed, quarantined}},
+	}
+
+	for _, tt := range tests {
+		subscribers, _, err := client.Subscriber.ListSubscribers(ctx, pageID, &statuspage.SubscriberListOptions{State: tt.state})
+		if err != nil {
+			t.Fatalf("SubscriberService.ListSubscribers(%q) returned error: %v", tt.state, err)
+		}
+		var got []string
+		for _, subscriber := range *subscribers {
+			got = append(got, *subscriber.ID)
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("SubscriberService.ListSubscribers(%q) returned %v, want %v", tt.state, got, tt.want)
+		}
+	}
+
+	_, _, err := client.Subscriber.ListSubscribers(ctx, pageID, &statuspage.SubscriberListOptions{State: "gone"})
+	if !errors.As(err, new(*statuspage.ErrorResponse)) {
+		t.Errorf("SubscriberService.ListSubscribers with invalid state returned error %v, want error response", err)
+	}
+}
+
+func TestServer_validation(t *testing.T) {
+	s, pageID := newTestServer(t)
+	client := s.Client()
+	ctx := context.Background()
+
+	_, _, err := client.Component.CreateComponent(ctx, pageID, statuspage.CreateComponentParams{Description: "No name"})
+	if !statuspage.IsUnprocessable(err) {
+		t.Fatalf("ComponentService.CreateComponent returned error %v, want unprocessable", err)
+	}
+
+	_, _, err = client.Incident.CreateIncident(ctx, pageID, statuspage.CreateIncidentParams{
+		Name:         "Outage",
+		ComponentIDs: []string{"unknown"},
+	})
+	if !statuspage.IsUnprocessable(err) {
+		t.Errorf("IncidentService.CreateIncident returned error %v, want unprocessable", err)
+	}
+
+	_, _, err = client.Subscriber.CreateSubscriber(ctx, pageID, statuspage.CreateSubscriberParams{})
+	if !statuspage.IsUnprocessable(err) {
+		t.Errorf("SubscriberService.CreateSubscriber returned error %v, want unprocessable", err)
+	}
+
+	_, _, err = client.Page.GetPage(ctx, "unknown")
+	if !statuspage.IsNotFound(err) {
+		t.Errorf("PageService.GetPage returned error %v, want not found", err)
+	}
+}
+
+func TestServer_authentication(t *testing.T) {
+	s, pageID := newTestServer(t)
+	client := statuspage.NewClient("wrong", statuspage.WithBaseURL(s.Client().BaseURL))
+
+	_, _, err := client.Page.GetPage(context.Background(), pageID)
+	if !statuspage.IsUnauthorized(err) {
+		t.Errorf("PageService.GetPage returned error %v, want unauthorized", err)
+	}
+}
+
+func TestServer_InjectFault(t *testing.T) {
+	s, pageID := newTestServer(t)
+	s.InjectFault(Fault{StatusCode: http.StatusTooManyRequests, Times: 2})
+	client := s.Client(statuspage.WithRetryPolicy(&statuspage.RetryPolicy{
+		MaxRetries: 2,
+		MinBackoff: time.Millisecond,
+		MaxBackoff: time.Millisecond,
+	}))
+
+	page, resp, err := client.Page.GetPage(context.Background(), pageID)
+	if err != nil {
+		t.Fatalf("PageService.GetPage returned error: %v", err)
+	}
+	if *page.Name != "Example" {
+		t.Errorf("PageService.GetPage returned %+v", page)
+	}
+	if got := s.Requests(); got != 3 {
+		t.Errorf("Server received %d requests, want 3", got)
+	}
+	if resp.Rate.Limit != 3600 || resp.Rate.Remaining != 3597 {
+		t.Errorf("Response rate is %+v, want limit 3600 and 3597 remaining", resp.Rate)
+	}
+
+	s.InjectFault(Fault{StatusCode: http.StatusServiceUnavailable})
+	_, _, err = s.Client().Page.GetPage(context.Background(), pageID)
+	var errResp *statuspage.ErrorResponse
+	if !errors.As(err, &errResp) || errResp.StatusCode != http.StatusServiceUnavailable {
+		t.Errorf("PageService.GetPage returned error %v, want 503", err)
+	}
+}
+
+func TestServer_SetLatency(t *testing.T) {
+	s, pageID := newTestServer(t)
+	s.SetLatency(time.Second)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, _, err := s.Client().Page.GetPage(ctx, pageID)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("PageService.GetPage returned error %v, want deadline exceeded", err)
+	}
+}
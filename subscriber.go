@@ -0,0 +1,407 @@
+package statuspage
+
+import (
+	"context"
+	"iter"
+)
+
+// SubscriberService handles communication with the subscriber related
+// methods of the Statuspage API.
+//
+// Statuspage API docs: https://developer.statuspage.io/#tag/subscribers
+type SubscriberService service
+
+// SubscriberAPI is the interface implemented by SubscriberService.
+type SubscriberAPI interface {
+	ListSubscribers(ctx context.Context, pageID string, opts *SubscriberListOptions) (*[]Subscriber, *Response, error)
+	All(ctx context.Context, pageID string, opts SubscriberListOptions) iter.Seq2[Subscriber, error]
+	GetSubscriber(ctx context.Context, pageID string, subscriberID string) (*Subscriber, *Response, error)
+	CreateSubscriber(ctx context.Context, pageID string, subscriber CreateSubscriberParams) (*Subscriber, *Response, error)
+	UpdateSubscriber(ctx context.Context, pageID string, subscriberID string, subscriber UpdateSubscriberParams) (*Subscriber, *Response, error)
+	UnsubscribeSubscriber(ctx context.Context, pageID string, subscriberID string) (*Subscriber, *Response, error)
+	ResendConfirmation(ctx context.Context, pageID string, subscriberID string) (*Response, error)
+	ResendConfirmations(ctx context.Context, pageID string, subscriberIDs []string) (*Response, error)
+	UnsubscribeSubscribers(ctx context.Context, pageID string, body BulkSubscribersRequestBody) (*Response, error)
+	ReactivateSubscribers(ctx context.Context, pageID string, body BulkSubscribersRequestBody) (*Response, error)
+	CountSubscribers(ctx context.Context, pageID string, opts *SubscriberCountOptions) (*SubscriberCountByType, *Response, error)
+	GetSubscriberHistogram(ctx context.Context, pageID string) (*SubscriberCountByState, *Response, error)
+	ListIncidentSubscribers(ctx context.Context, pageID string, incidentID string, opts *ListOptions) (*[]Subscriber, *Response, error)
+	GetIncidentSubscriber(ctx context.Context, pageID string, incidentID string, subscriberID string) (*Subscriber, *Response, error)
+	CreateIncidentSubscriber(ctx context.Context, pageID string, incidentID string, subscriber CreateIncidentSubscriberParams) (*Subscriber, *Response, error)
+	UnsubscribeIncidentSubscriber(ctx context.Context, pageID string, incidentID string, subscriberID string) (*Subscriber, *Response, error)
+	ResendIncidentSubscriberConfirmation(ctx context.Context, pageID string, incidentID string, subscriberID string) (*Response, error)
+}
+
+var _ SubscriberAPI = (*SubscriberService)(nil)
+
+// SubscriberType is the notification channel of a subscriber.
+type SubscriberType string
+
+// Subscriber types used to filter subscribers.
+const (
+	SubscriberTypeEmail              SubscriberType = "email"
+	SubscriberTypeSMS                SubscriberType = "sms"
+	SubscriberTypeWebhook            SubscriberType = "webhook"
+	SubscriberTypeSlack              SubscriberType = "slack"
+	SubscriberTypeIntegrationPartner SubscriberType = "integration_partner"
+)
+
+// SubscriberState is the confirmation state of a subscriber.
+type SubscriberState string
+
+// Subscriber states used to filter subscribers.
+const (
+	SubscriberStateActive      SubscriberState = "active"
+	SubscriberStateUnconfirmed SubscriberState = "unconfirmed"
+	SubscriberStateQuarantined SubscriberState = "quarantined"
+	SubscriberStateAll         SubscriberState = "all"
+)
+
+// Subscriber is the Statuspage API subscriber representation
+type Subscriber struct {
+	ID                           *string    `json:"id,omitempty"`
+	Mode                         *string    `json:"mode,omitempty"`
+	Email                        *string    `json:"email,omitempty"`
+	Endpoint                     *string    `json:"endpoint,omitempty"`
+	PhoneNumber                  *string    `json:"phone_number,omitempty"`
+	PhoneCountry                 *string    `json:"phone_country,omitempty"`
+	DisplayPhoneNumber           *string    `json:"display_phone_number,omitempty"`
+	ObfuscatedChannelName        *string    `json:"obfuscated_channel_name,omitempty"`
+	WorkspaceName                *string    `json:"workspace_name,omitempty"`
+	SkipConfirmationNotification *bool      `json:"skip_confirmation_notification,omitempty"`
+	QuarantinedAt                *Timestamp `json:"quarantined_at,omitempty"`
+	PurgeAt                      *Timestamp `json:"purge_at,omitempty"`
+	Components                   []string   `json:"components,omitempty"`
+	PageAccessUserID             *string    `json:"page_access_user_id,omitempty"`
+	CreatedAt                    *Timestamp `json:"created_at,omitempty"`
+}
+
+func (s Subscriber) String() string {
+	return Stringify(s)
+}
+
+// SubscriberListOptions specifies the optional parameters to the
+// SubscriberService.ListSubscribers method.
+type SubscriberListOptions struct {
+	// Q filters subscribers by email address, phone number or endpoint.
+	Q     string          `url:"q,omitempty"`
+	Type  SubscriberType  `url:"type,omitempty"`
+	State SubscriberState `url:"state,omitempty"`
+
+	// SortField is one of primary, created_at, quarantined_at or relevance.
+	SortField string `url:"sort_field,omitempty"`
+	// SortDirection is either asc or desc.
+	SortDirection string `url:"sort_direction,omitempty"`
+
+	ListOptions
+}
+
+// ListSubscribers returns a list of subscribers for a given page id
+func (s *SubscriberService) ListSubscribers(ctx context.Context, pageID string, opts *SubscriberListOptions) (*[]Subscriber, *Response, error) {
+	path, err := addOptions("v1/pages/"+pageID+"/subscribers", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.newRequest("GET", path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var subscribers []Subscriber
+	resp, err := s.client.do(ctx, req, &subscribers)
+
+	return &subscribers, resp, err
+}
+
+// All returns an iterator over all subscribers for a given page id matching
+// the filters in opts, fetching further result pages as needed. The
+// pagination fields of opts are ignored.
+func (s *SubscriberService) All(ctx context.Context, pageID string, opts SubscriberListOptions) iter.Seq2[Subscriber, error] {
+	return allPages(ctx, func(ctx context.Context, listOpts *ListOptions) (*[]Subscriber, *Response, error) {
+		opts.ListOptions = *listOpts
+		return s.ListSubscribers(ctx, pageID, &opts)
+	})
+}
+
+// GetSubscriber returns subscriber information for a given page and subscriber id
+func (s *SubscriberService) GetSubscriber(ctx context.Context, pageID string, subscriberID string) (*Subscriber, *Response, error) {
+	path := "v1/pages/" + pageID + "/subscribers/" + subscriberID
+	req, err := s.client.newRequest("GET", path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var subscriber Subscriber
+	resp, err := s.client.do(ctx, req, &subscriber)
+
+	return &subscriber, resp, err
+}
+
+// CreateSubscriberParams are the parameters that can be set using the create subscriber API endpoint.
+// Exactly one of Email, PhoneNumber or Endpoint determines the subscriber type.
+type CreateSubscriberParams struct {
+	Email                        string   `json:"email,omitempty"`
+	Endpoint                     string   `json:"endpoint,omitempty"`
+	PhoneCountry                 string   `json:"phone_country,omitempty"`
+	PhoneNumber                  string   `json:"phone_number,omitempty"`
+	SkipConfirmationNotification *bool    `json:"skip_confirmation_notification,omitempty"`
+	PageAccessUser               string   `json:"page_access_user,omitempty"`
+	ComponentIDs                 []string `json:"component_ids,omitempty"`
+}
+
+// CreateSubscriberRequestBody is the create subscriber request body representation
+type CreateSubscriberRequestBody struct {
+	Subscriber CreateSubscriberParams `json:"subscriber"`
+}
+
+// CreateSubscriber creates a subscriber for a given page id
+func (s *SubscriberService) CreateSubscriber(ctx context.Context, pageID string, subscriber CreateSubscriberParams) (*Subscriber, *Response, error) {
+	path := "v1/pages/" + pageID + "/subscribers"
+	payload := CreateSubscriberRequestBody{Subscriber: subscriber}
+	req, err := s.client.newRequest("POST", path, payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var createdSubscriber Subscriber
+	resp, err := s.client.do(ctx, req, &createdSubscriber)
+
+	return &createdSubscriber, resp, err
+}
+
+// UpdateSubscriberParams are the parameters that can be changed using the update subscriber API endpoint
+type UpdateSubscriberParams struct {
+	ComponentIDs []string `json:"component_ids,omitempty"`
+}
+
+// UpdateSubscriber updates the components a subscriber for a given page and subscriber id is subscribed to
+func (s *SubscriberService) UpdateSubscriber(ctx context.Context, pageID string, subscriberID string, subscriber UpdateSubscriberParams) (*Subscriber, *Response, error) {
+	path := "v1/pages/" + pageID + "/subscribers/" + subscriberID
+	req, err := s.client.newRequest("PATCH", path, subscriber)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var updatedSubscriber Subscriber
+	resp, err := s.client.do(ctx, req, &updatedSubscriber)
+
+	return &updatedSubscriber, resp, err
+}
+
+// UnsubscribeSubscriber unsubscribes a subscriber for a given page and subscriber id
+func (s *SubscriberService) UnsubscribeSubscriber(ctx context.Context, pageID string, subscriberID string) (*Subscriber, *Response, error) {
+	path := "v1/pages/" + pageID + "/subscribers/" + subscriberID
+	req, err := s.client.newRequest("DELETE", path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var subscriber Subscriber
+	resp, err := s.client.do(ctx, req, &subscriber)
+
+	return &subscriber, resp, err
+}
+
+// ResendConfirmation resends the confirmation message to a subscriber for a given page and subscriber id
+func (s *SubscriberService) ResendConfirmation(ctx context.Context, pageID string, subscriberID string) (*Response, error) {
+	path := "v1/pages/" + pageID + "/subscribers/" + subscriberID + "/resend_confirmation"
+	req, err := s.client.newRequest("POST", path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.do(ctx, req, nil)
+}
+
+// BulkSubscribersRequestBody is the request body representation of the bulk subscriber API endpoints
+type BulkSubscribersRequestBody struct {
+	// Subscribers lists the subscriber ids to act on, or ["all"] to act on
+	// every subscriber matching Type and State.
+	Subscribers                    []string        `json:"subscribers"`
+	Type                           SubscriberType  `json:"type,omitempty"`
+	State                          SubscriberState `json:"state,omitempty"`
+	SkipUnsubscriptionNotification *bool           `json:"skip_unsubscription_notification,omitempty"`
+}
+
+// ResendConfirmations resends the confirmation message to the given subscribers of a given page id
+func (s *SubscriberService) ResendConfirmations(ctx context.Context, pageID string, subscriberIDs []string) (*Response, error) {
+	return s.bulk(ctx, pageID, "resend_confirmation", BulkSubscribersRequestBody{Subscribers: subscriberIDs})
+}
+
+// UnsubscribeSubscribers unsubscribes many subscribers of a given page id at once
+func (s *SubscriberService) UnsubscribeSubscribers(ctx context.Context, pageID string, body BulkSubscribersRequestBody) (*Response, error) {
+	return s.bulk(ctx, pageID, "unsubscribe", body)
+}
+
+// ReactivateSubscribers reactivates many quarantined subscribers of a given page id at once
+func (s *SubscriberService) ReactivateSubscribers(ctx context.Context, pageID string, body BulkSubscribersRequestBody) (*Response, error) {
+	return s.bulk(ctx, pageID, "reactivate", body)
+}
+
+func (s *SubscriberService) bulk(ctx context.Context, pageID string, action string, body BulkSubscribersRequestBody) (*Response, error) {
+	path := "v1/pages/" + pageID + "/subscribers/" + action
+	req, err := s.client.newRequest("POST", path, body)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.do(ctx, req, nil)
+}
+
+// SubscriberCountByType is the Statuspage API representation of subscriber counts per type
+type SubscriberCountByType struct {
+	Email              *int64 `json:"email,omitempty"`
+	SMS                *int64 `json:"sms,omitempty"`
+	Webhook            *int64 `json:"webhook,omitempty"`
+	Slack              *int64 `json:"slack,omitempty"`
+	IntegrationPartner *int64 `json:"integration_partner,omitempty"`
+}
+
+func (c SubscriberCountByType) String() string {
+	return Stringify(c)
+}
+
+// SubscriberCountOptions specifies the optional parameters to the
+// SubscriberService.CountSubscribers method.
+type SubscriberCountOptions struct {
+	Type  SubscriberType  `url:"type,omitempty"`
+	State SubscriberState `url:"state,omitempty"`
+}
+
+// CountSubscribers returns the number of subscribers per type for a given page id
+func (s *SubscriberService) CountSubscribers(ctx context.Context, pageID string, opts *SubscriberCountOptions) (*SubscriberCountByType, *Response, error) {
+	path, err := addOptions("v1/pages/"+pageID+"/subscribers/count", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.newRequest("GET", path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var count SubscriberCountByType
+	resp, err := s.client.do(ctx, req, &count)
+
+	return &count, resp, err
+}
+
+// SubscriberCountByStateEntry is the Statuspage API representation of subscriber counts per state
+type SubscriberCountByStateEntry struct {
+	Active      *int64 `json:"active,omitempty"`
+	Unconfirmed *int64 `json:"unconfirmed,omitempty"`
+	Quarantined *int64 `json:"quarantined,omitempty"`
+}
+
+// SubscriberCountByState is the Statuspage API representation of the subscriber histogram
+type SubscriberCountByState struct {
+	Email              *SubscriberCountByStateEntry `json:"email,omitempty"`
+	SMS                *SubscriberCountByStateEntry `json:"sms,omitempty"`
+	Webhook            *SubscriberCountByStateEntry `json:"webhook,omitempty"`
+	Slack              *SubscriberCountByStateEntry `json:"slack,omitempty"`
+	IntegrationPartner *SubscriberCountByStateEntry `json:"integration_partner,omitempty"`
+}
+
+func (c SubscriberCountByState) String() string {
+	return Stringify(c)
+}
+
+// GetSubscriberHistogram returns the number of subscribers per type and state for a given page id
+func (s *SubscriberService) GetSubscriberHistogram(ctx context.Context, pageID string) (*SubscriberCountByState, *Response, error) {
+	path := "v1/pages/" + pageID + "/subscribers/histogram_by_state"
+	req, err := s.client.newRequest("GET", path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var histogram SubscriberCountByState
+	resp, err := s.client.do(ctx, req, &histogram)
+
+	return &histogram, resp, err
+}
+
+// ListIncidentSubscribers returns a list of subscribers for a given page and incident id
+func (s *SubscriberService) ListIncidentSubscribers(ctx context.Context, pageID string, incidentID string, opts *ListOptions) (*[]Subscriber, *Response, error) {
+	path, err := addOptions("v1/pages/"+pageID+"/incidents/"+incidentID+"/subscribers", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.newRequest("GET", path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var subscribers []Subscriber
+	resp, err := s.client.do(ctx, req, &subscribers)
+
+	return &subscribers, resp, err
+}
+
+// GetIncidentSubscriber returns subscriber information for a given page, incident and subscriber id
+func (s *SubscriberService) GetIncidentSubscriber(ctx context.Context, pageID string, incidentID string, subscriberID string) (*Subscriber, *Response, error) {
+	path := "v1/pages/" + pageID + "/incidents/" + incidentID + "/subscribers/" + subscriberID
+	req, err := s.client.newRequest("GET", path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var subscriber Subscriber
+	resp, err := s.client.do(ctx, req, &subscriber)
+
+	return &subscriber, resp, err
+}
+
+// CreateIncidentSubscriberParams are the parameters that can be set using the create incident subscriber API endpoint
+type CreateIncidentSubscriberParams struct {
+	Email                        string `json:"email,omitempty"`
+	PhoneCountry                 string `json:"phone_country,omitempty"`
+	PhoneNumber                  string `json:"phone_number,omitempty"`
+	SkipConfirmationNotification *bool  `json:"skip_confirmation_notification,omitempty"`
+}
+
+// CreateIncidentSubscriberRequestBody is the create incident subscriber request body representation
+type CreateIncidentSubscriberRequestBody struct {
+	Subscriber CreateIncidentSubscriberParams `json:"subscriber"`
+}
+
+// CreateIncidentSubscriber creates a subscriber for a given page and incident id
+func (s *SubscriberService) CreateIncidentSubscriber(ctx context.Context, pageID string, incidentID string, subscriber CreateIncidentSubscriberParams) (*Subscriber, *Response, error) {
+	path := "v1/pages/" + pageID + "/incidents/" + incidentID + "/subscribers"
+	payload := CreateIncidentSubscriberRequestBody{Subscriber: subscriber}
+	req, err := s.client.newRequest("POST", path, payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var createdSubscriber Subscriber
+	resp, err := s.client.do(ctx, req, &createdSubscriber)
+
+	return &createdSubscriber, resp, err
+}
+
+// UnsubscribeIncidentSubscriber unsubscribes a subscriber for a given page, incident and subscriber id
+func (s *SubscriberService) UnsubscribeIncidentSubscriber(ctx context.Context, pageID string, incidentID string, subscriberID string) (*Subscriber, *Response, error) {
+	path := "v1/pages/" + pageID + "/incidents/" + incidentID + "/subscribers/" + subscriberID
+	req, err := s.client.newRequest("DELETE", path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var subscriber Subscriber
+	resp, err := s.client.do(ctx, req, &subscriber)
+
+	return &subscriber, resp, err
+}
+
+// ResendIncidentSubscriberConfirmation resends the confirmation message to a subscriber for a given page, incident and subscriber id
+func (s *SubscriberService) ResendIncidentSubscriberConfirmation(ctx context.Context, pageID string, incidentID string, subscriberID string) (*Response, error) {
+	path := "v1/pages/" + pageID + "/incidents/" + incidentID + "/subscribers/" + subscriberID + "/resend_confirmation"
+	req, err := s.client.newRequest("POST", path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.do(ctx, req, nil)
+}
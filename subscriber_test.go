@@ -0,0 +1,451 @@
+package statuspage
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestSubscriber_marshall(t *testing.T) {
+	testJSONMarshal(t, &Subscriber{}, "{}")
+
+	u := &Subscriber{
+		ID:                           String("a"),
+		Mode:                         String("email"),
+		Email:                        String("b"),
+		Endpoint:                     String("c"),
+		PhoneNumber:                  String("d"),
+		PhoneCountry:                 String("e"),
+		DisplayPhoneNumber:           String("f"),
+		ObfuscatedChannelName:        String("g"),
+		WorkspaceName:                String("h"),
+		SkipConfirmationNotification: Bool(true),
+		QuarantinedAt:                &Timestamp{referenceTime},
+		PurgeAt:                      &Timestamp{referenceTime},
+		Components:                   []string{"i"},
+		PageAccessUserID:             String("j"),
+		CreatedAt:                    &Timestamp{referenceTime},
+	}
+	want := `{
+		"id": "a",
+		"mode": "email",
+		"email": "b",
+		"endpoint": "c",
+		"phone_number": "d",
+		"phone_country": "e",
+		"display_phone_number": "f",
+		"obfuscated_channel_name": "g",
+		"workspace_name": "h",
+		"skip_confirmation_notification": true,
+		"quarantined_at": "2006-01-02T15:04:05Z",
+		"purge_at": "2006-01-02T15:04:05Z",
+		"components": ["i"],
+		"page_access_user_id": "j",
+		"created_at": "2006-01-02T15:04:05Z"
+	}`
+	testJSONMarshal(t, u, want)
+}
+
+func TestSubscriberService_ListSubscribers(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v1/pages/1/subscribers", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if got, want := r.URL.RawQuery, "page=2&q=a&state=quarantined&type=email"; got != want {
+			t.Errorf("Query = %v, want %v", got, want)
+		}
+		fmt.Fprint(w, `[{"id":"1"}, {"id":"2"}]`)
+	})
+
+	opts := &SubscriberListOptions{
+		Q:           "a",
+		Type:        SubscriberTypeEmail,
+		State:       SubscriberStateQuarantined,
+		ListOptions: ListOptions{Page: 2},
+	}
+	subscribers, _, err := client.Subscriber.ListSubscribers(context.Background(), "1", opts)
+	if err != nil {
+		t.Errorf("SubscriberService.ListSubscribers returned error: %v", err)
+	}
+
+	want := &[]Subscriber{
+		{ID: String("1")},
+		{ID: String("2")},
+	}
+	if !reflect.DeepEqual(subscribers, want) {
+		t.Errorf("SubscriberService.ListSubscribers returned %+v, want %+v", subscribers, want)
+	}
+}
+
+func TestSubscriberService_All(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v1/pages/1/subscribers", func(w http.ResponseWriter, r *http.Request) {
+		if got, want := r.URL.Query().Get("type"), "sms"; got != want {
+			t.Errorf("type = %v, want %v", got, want)
+		}
+		if got, want := r.URL.Query().Get("page"), "1"; got != want {
+			t.Errorf("page = %v, want %v", got, want)
+		}
+		fmt.Fprint(w, `[{"id":"1"}]`)
+	})
+
+	var subscribers []Subscriber
+	for subscriber, err := range client.Subscriber.All(context.Background(), "1", SubscriberListOptions{Type: SubscriberTypeSMS}) {
+		if err != nil {
+			t.Fatalf("SubscriberService.All returned error: %v", err)
+		}
+		subscribers = append(subscribers, subscriber)
+	}
+
+	if want := []Subscriber{{ID: String("1")}}; !reflect.DeepEqual(subscribers, want) {
+		t.Errorf("SubscriberService.All returned %+v, want %+v", subscribers, want)
+	}
+}
+
+func TestSubscriberService_GetSubscriber(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v1/pages/1/subscribers/2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id":"2"}`)
+	})
+
+	subscriber, _, err := client.Subscriber.GetSubscriber(context.Background(), "1", "2")
+	if err != nil {
+		t.Errorf("SubscriberService.GetSubscriber returned error: %v", err)
+	}
+
+	want := &Subscriber{ID: String("2")}
+	if !reflect.DeepEqual(subscriber, want) {
+		t.Errorf("SubscriberService.GetSubscriber returned %+v, want %+v", subscriber, want)
+	}
+}
+
+func TestSubscriberService_CreateSubscriber(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	input := CreateSubscriberParams{
+		Email:                        "a",
+		SkipConfirmationNotification: Bool(true),
+		ComponentIDs:                 []string{"b"},
+	}
+
+	mux.HandleFunc("/v1/pages/1/subscribers", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+
+		v := &CreateSubscriberRequestBody{}
+		json.NewDecoder(r.Body).Decode(v)
+		if !reflect.DeepEqual(v.Subscriber, input) {
+			t.Errorf("Request body = %+v, want %+v", v.Subscriber, input)
+		}
+
+		fmt.Fprint(w, `{"id":"2", "email":"a"}`)
+	})
+
+	subscriber, _, err := client.Subscriber.CreateSubscriber(context.Background(), "1", input)
+	if err != nil {
+		t.Errorf("SubscriberService.CreateSubscriber returned error: %v", err)
+	}
+
+	want := &Subscriber{ID: String("2"), Email: String("a")}
+	if !reflect.DeepEqual(subscriber, want) {
+		t.Errorf("SubscriberService.CreateSubscriber returned %+v, want %+v", subscriber, want)
+	}
+}
+
+func TestSubscriberService_UpdateSubscriber(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	input := UpdateSubscriberParams{ComponentIDs: []string{"a"}}
+
+	mux.HandleFunc("/v1/pages/1/subscribers/2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+
+		v := &UpdateSubscriberParams{}
+		json.NewDecoder(r.Body).Decode(v)
+		if !reflect.DeepEqual(*v, input) {
+			t.Errorf("Request body = %+v, want %+v", v, input)
+		}
+
+		fmt.Fprint(w, `{"id":"2", "components":["a"]}`)
+	})
+
+	subscriber, _, err := client.Subscriber.UpdateSubscriber(context.Background(), "1", "2", input)
+	if err != nil {
+		t.Errorf("SubscriberService.UpdateSubscriber returned error: %v", err)
+	}
+
+	want := &Subscriber{ID: String("2"), Components: []string{"a"}}
+	if !reflect.DeepEqual(subscriber, want) {
+		t.Errorf("SubscriberService.UpdateSubscriber returned %+v, want %+v", subscriber, want)
+	}
+}
+
+func TestSubscriberService_UnsubscribeSubscriber(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v1/pages/1/subscribers/2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		fmt.Fprint(w, `{"id":"2"}`)
+	})
+
+	subscriber, _, err := client.Subscriber.UnsubscribeSubscriber(context.Background(), "1", "2")
+	if err != nil {
+		t.Errorf("SubscriberService.UnsubscribeSubscriber returned error: %v", err)
+	}
+
+	want := &Subscriber{ID: String("2")}
+	if !reflect.DeepEqual(subscriber, want) {
+		t.Errorf("SubscriberService.UnsubscribeSubscriber returned %+v, want %+v", subscriber, want)
+	}
+}
+
+func TestSubscriberService_ResendConfirmation(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v1/pages/1/subscribers/2/resend_confirmation", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		w.WriteHeader(http.StatusCreated)
+	})
+
+	_, err := client.Subscriber.ResendConfirmation(context.Background(), "1", "2")
+	if err != nil {
+		t.Errorf("SubscriberService.ResendConfirmation returned error: %v", err)
+	}
+}
+
+func TestSubscriberService_bulk(t *testing.T) {
+	tests := []struct {
+		name string
+		path string
+		want BulkSubscribersRequestBody
+		call func(client *Client) (*Response, error)
+	}{
+		{
+			name: "ResendConfirmations",
+			path: "/v1/pages/1/subscribers/resend_confirmation",
+			want: BulkSubscribersRequestBody{Subscribers: []string{"a", "b"}},
+			call: func(c *Client) (*Response, error) {
+				return c.Subscriber.ResendConfirmations(context.Background(), "1", []string{"a", "b"})
+			},
+		},
+		{
+			name: "UnsubscribeSubscribers",
+			path: "/v1/pages/1/subscribers/unsubscribe",
+			want: BulkSubscribersRequestBody{
+				Subscribers:                    []string{"all"},
+				Type:                           SubscriberTypeEmail,
+				State:                          SubscriberStateUnconfirmed,
+				SkipUnsubscriptionNotification: Bool(true),
+			},
+			call: func(c *Client) (*Response, error) {
+				return c.Subscriber.UnsubscribeSubscribers(context.Background(), "1", BulkSubscribersRequestBody{
+					Subscribers:                    []string{"all"},
+					Type:                           SubscriberTypeEmail,
+					State:                          SubscriberStateUnconfirmed,
+					SkipUnsubscriptionNotification: Bool(true),
+				})
+			},
+		},
+		{
+			name: "ReactivateSubscribers",
+			path: "/v1/pages/1/subscribers/reactivate",
+			want: BulkSubscribersRequestBody{Subscribers: []string{"a"}, Type: SubscriberTypeSMS},
+			call: func(c *Client) (*Response, error) {
+				return c.Subscriber.ReactivateSubscribers(context.Background(), "1", BulkSubscribersRequestBody{
+					Subscribers: []string{"a"},
+					Type:        SubscriberTypeSMS,
+				})
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, mux, _, teardown := setup()
+			defer teardown()
+
+			mux.HandleFunc(tt.path, func(w http.ResponseWriter, r *http.Request) {
+				testMethod(t, r, "POST")
+
+				v := &BulkSubscribersRequestBody{}
+				json.NewDecoder(r.Body).Decode(v)
+				if !reflect.DeepEqual(*v, tt.want) {
+					t.Errorf("Request body = %+v, want %+v", v, tt.want)
+				}
+
+				w.WriteHeader(http.StatusAccepted)
+			})
+
+			if _, err := tt.call(client); err != nil {
+				t.Errorf("SubscriberService.%s returned error: %v", tt.name, err)
+			}
+		})
+	}
+}
+
+func TestSubscriberService_CountSubscribers(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v1/pages/1/subscribers/count", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if got, want := r.URL.RawQuery, "state=active&type=webhook"; got != want {
+			t.Errorf("Query = %v, want %v", got, want)
+		}
+		fmt.Fprint(w, `{"email":1, "sms":2, "webhook":3, "slack":4, "integration_partner":5}`)
+	})
+
+	opts := &SubscriberCountOptions{Type: SubscriberTypeWebhook, State: SubscriberStateActive}
+	count, _, err := client.Subscriber.CountSubscribers(context.Background(), "1", opts)
+	if err != nil {
+		t.Errorf("SubscriberService.CountSubscribers returned error: %v", err)
+	}
+
+	want := &SubscriberCountByType{
+		Email:              Int64(1),
+		SMS:                Int64(2),
+		Webhook:            Int64(3),
+		Slack:              Int64(4),
+		IntegrationPartner: Int64(5),
+	}
+	if !reflect.DeepEqual(count, want) {
+		t.Errorf("SubscriberService.CountSubscribers returned %+v, want %+v", count, want)
+	}
+}
+
+func TestSubscriberService_GetSubscriberHistogram(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v1/pages/1/subscribers/histogram_by_state", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"email":{"active":1, "unconfirmed":2, "quarantined":3}}`)
+	})
+
+	histogram, _, err := client.Subscriber.GetSubscriberHistogram(context.Background(), "1")
+	if err != nil {
+		t.Errorf("SubscriberService.GetSubscriberHistogram returned error: %v", err)
+	}
+
+	want := &SubscriberCountByState{
+		Email: &SubscriberCountByStateEntry{
+			Active:      Int64(1),
+			Unconfirmed: Int64(2),
+			Quarantined: Int64(3),
+		},
+	}
+	if !reflect.DeepEqual(histogram, want) {
+		t.Errorf("SubscriberService.GetSubscriberHistogram returned %+v, want %+v", histogram, want)
+	}
+}
+
+func TestSubscriberService_ListIncidentSubscribers(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v1/pages/1/incidents/2/subscribers", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[{"id":"3"}]`)
+	})
+
+	subscribers, _, err := client.Subscriber.ListIncidentSubscribers(context.Background(), "1", "2", nil)
+	if err != nil {
+		t.Errorf("SubscriberService.ListIncidentSubscribers returned error: %v", err)
+	}
+
+	want := &[]Subscriber{{ID: String("3")}}
+	if !reflect.DeepEqual(subscribers, want) {
+		t.Errorf("SubscriberService.ListIncidentSubscribers returned %+v, want %+v", subscribers, want)
+	}
+}
+
+func TestSubscriberService_GetIncidentSubscriber(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v1/pages/1/incidents/2/subscribers/3", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id":"3"}`)
+	})
+
+	subscriber, _, err := client.Subscriber.GetIncidentSubscriber(context.Background(), "1", "2", "3")
+	if err != nil {
+		t.Errorf("SubscriberService.GetIncidentSubscriber returned error: %v", err)
+	}
+
+	want := &Subscriber{ID: String("3")}
+	if !reflect.DeepEqual(subscriber, want) {
+		t.Errorf("SubscriberService.GetIncidentSubscriber returned %+v, want %+v", subscriber, want)
+	}
+}
+
+func TestSubscriberService_CreateIncidentSubscriber(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	input := CreateIncidentSubscriberParams{PhoneCountry: "US", PhoneNumber: "a"}
+
+	mux.HandleFunc("/v1/pages/1/incidents/2/subscribers", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+
+		v := &CreateIncidentSubscriberRequestBody{}
+		json.NewDecoder(r.Body).Decode(v)
+		if !reflect.DeepEqual(v.Subscriber, input) {
+			t.Errorf("Request body = %+v, want %+v", v.Subscriber, input)
+		}
+
+		fmt.Fprint(w, `{"id":"3"}`)
+	})
+
+	subscriber, _, err := client.Subscriber.CreateIncidentSubscriber(context.Background(), "1", "2", input)
+	if err != nil {
+		t.Errorf("SubscriberService.CreateIncidentSubscriber returned error: %v", err)
+	}
+
+	want := &Subscriber{ID: String("3")}
+	if !reflect.DeepEqual(subscriber, want) {
+		t.Errorf("SubscriberService.CreateIncidentSubscriber returned %+v, want %+v", subscriber, want)
+	}
+}
+
+func TestSubscriberService_UnsubscribeIncidentSubscriber(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v1/pages/1/incidents/2/subscribers/3", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		fmt.Fprint(w, `{"id":"3"}`)
+	})
+
+	_, _, err := client.Subscriber.UnsubscribeIncidentSubscriber(context.Background(), "1", "2", "3")
+	if err != nil {
+		t.Errorf("SubscriberService.UnsubscribeIncidentSubscriber returned error: %v", err)
+	}
+}
+
+func TestSubscriberService_ResendIncidentSubscriberConfirmation(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v1/pages/1/incidents/2/subscribers/3/resend_confirmation", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		w.WriteHeader(http.StatusCreated)
+	})
+
+	_, err := client.Subscriber.ResendIncidentSubscriberConfirmation(context.Background(), "1", "2", "3")
+	if err != nil {
+		t.Errorf("SubscriberService.ResendIncidentSubscriberConfirmation returned error: %v", err)
+	}
+}
@@ -0,0 +1,210 @@
+package statuspage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"iter"
+)
+
+// TemplateService handles communication with the incident template related
+// methods of the Statuspage API.
+//
+// Statuspage API docs: https://developer.statuspage.io/#tag/incident-templates
+type TemplateService service
+
+// TemplateAPI is the interface implemented by TemplateService.
+type TemplateAPI interface {
+	ListTemplates(ctx context.Context, pageID string, opts *ListOptions) (*[]IncidentTemplate, *Response, error)
+	All(ctx context.Context, pageID string) iter.Seq2[IncidentTemplate, error]
+	CreateTemplate(ctx context.Context, pageID string, template CreateTemplateParams) (*IncidentTemplate, *Response, error)
+}
+
+var _ TemplateAPI = (*TemplateService)(nil)
+
+// IncidentTemplate is the Statuspage API incident template representation
+type IncidentTemplate struct {
+	ID                      *string     `json:"id,omitempty"`
+	Components              []Component `json:"components,omitempty"`
+	Name                    *string     `json:"name,omitempty"`
+	Title                   *string     `json:"title,omitempty"`
+	Body                    *string     `json:"body,omitempty"`
+	GroupID                 *string     `json:"group_id,omitempty"`
+	UpdateStatus            *string     `json:"update_status,omitempty"`
+	ShouldTweet             *bool       `json:"should_tweet,omitempty"`
+	ShouldSendNotifications *bool       `json:"should_send_notifications,omitempty"`
+}
+
+func (t IncidentTemplate) String() string {
+	return Stringify(t)
+}
+
+// ListTemplates returns a list of incident templates for a given page id
+func (s *TemplateService) ListTemplates(ctx context.Context, pageID string, opts *ListOptions) (*[]IncidentTemplate, *Response, error) {
+	path, err := addOptions("v1/pages/"+pageID+"/incident_templates", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.newRequest("GET", path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var templates []IncidentTemplate
+	resp, err := s.client.do(ctx, req, &templates)
+
+	return &templates, resp, err
+}
+
+// All returns an iterator over all incident templates for a given page id,
+// fetching further result pages as needed
+func (s *TemplateService) All(ctx context.Context, pageID string) iter.Seq2[IncidentTemplate, error] {
+	return allPages(ctx, func(ctx context.Context, opts *ListOptions) (*[]IncidentTemplate, *Response, error) {
+		return s.ListTemplates(ctx, pageID, opts)
+	})
+}
+
+// CreateTemplateParams are the parameters that can be set using the create incident template API endpoint.
+// UpdateStatus is the status incidents created from the template start in.
+type CreateTemplateParams struct {
+	Name                    string         `json:"name,omitempty"`
+	Title                   string         `json:"title,omitempty"`
+	Body                    string         `json:"body,omitempty"`
+	GroupID                 string         `json:"group_id,omitempty"`
+	UpdateStatus            IncidentStatus `json:"update_status,omitempty"`
+	ShouldTweet             *bool          `json:"should_tweet,omitempty"`
+	ShouldSendNotifications *bool          `json:"should_send_notifications,omitempty"`
+	ComponentIDs            []string       `json:"component_ids,omitempty"`
+}
+
+// CreateTemplateRequestBody is the create incident template request body representation
+type CreateTemplateRequestBody struct {
+	Template CreateTemplateParams `json:"template"`
+}
+
+// CreateTemplate creates an incident template for a given page id
+func (s *TemplateService) CreateTemplate(ctx context.Context, pageID string, template CreateTemplateParams) (*IncidentTemplate, *Response, error) {
+	path := "v1/pages/" + pageID + "/incident_templates"
+	payload := CreateTemplateRequestBody{Template: template}
+	req, err := s.client.newRequest("POST", path, payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var createdTemplate IncidentTemplate
+	resp, err := s.client.do(ctx, req, &createdTemplate)
+
+	return &createdTemplate, resp, err
+}
+
+// IncidentTemplateOverrides are the fields of an incident created from a
+// template that replace the template's values. Empty strings and a nil
+// ComponentIDs keep the template's values.
+type IncidentTemplateOverrides struct {
+	Name   string
+	Body   string
+	Status IncidentStatus
+
+	// ComponentIDs replaces the components of the template. Components the
+	// template does not list are marked as degraded.
+	ComponentIDs []string
+
+	// Components sets the status of affected components, on top of the
+	// statuses taken from the template.
+	Components map[string]ComponentStatus
+}
+
+// CreateIncidentParams returns the create incident parameters for an incident
+// based on the template t, with overrides applied on top. The components of
+// the template keep their status unless it is operational, in which case they
+// are marked as degraded. overrides may be nil.
+func (t IncidentTemplate) CreateIncidentParams(overrides *IncidentTemplateOverrides) CreateIncidentParams {
+	params := CreateIncidentParams{
+		DeliverNotifications: t.ShouldSendNotifications,
+	}
+	if t.Title != nil {
+		params.Name = *t.Title
+	}
+	if t.Body != nil {
+		params.Body = *t.Body
+	}
+	if t.UpdateStatus != nil {
+		params.Status = IncidentStatus(*t.UpdateStatus)
+	}
+
+	statuses := map[string]ComponentStatus{}
+	for _, component := range t.Components {
+		if component.ID == nil {
+			continue
+		}
+		params.ComponentIDs = append(params.ComponentIDs, *component.ID)
+		status := ComponentStatusDegradedPerformance
+		if component.Status != nil {
+			if s := ComponentStatus(*component.Status); s.Valid() && s != ComponentStatusOperational {
+				status = s
+			}
+		}
+		statuses[*component.ID] = status
+	}
+
+	if overrides == nil {
+		overrides = &IncidentTemplateOverrides{}
+	}
+	if overrides.Name != "" {
+		params.Name = overrides.Name
+	}
+	if overrides.Body != "" {
+		params.Body = overrides.Body
+	}
+	if overrides.Status != "" {
+		params.Status = overrides.Status
+	}
+	if overrides.ComponentIDs != nil {
+		params.ComponentIDs = overrides.ComponentIDs
+	}
+
+	if len(params.ComponentIDs) > 0 || len(overrides.Components) > 0 {
+		params.Components = map[string]ComponentStatus{}
+	}
+	for _, id := range params.ComponentIDs {
+		if status, ok := statuses[id]; ok {
+			params.Components[id] = status
+		} else {
+			params.Components[id] = ComponentStatusDegradedPerformance
+		}
+	}
+	for id, status := range overrides.Components {
+		params.Components[id] = status
+	}
+
+	return params
+}
+
+// ErrTemplateNotFound is returned by CreateIncidentFromTemplate when the page
+// has no incident template with the given id. IsNotFound reports true for it.
+var ErrTemplateNotFound = errors.New("incident template not found")
+
+// CreateIncidentFromTemplate creates an incident for a given page id based on
+// the incident template with the given id, as described for
+// IncidentTemplate.CreateIncidentParams
+func (s *IncidentService) CreateIncidentFromTemplate(ctx context.Context, pageID string, templateID string, overrides *IncidentTemplateOverrides) (*Incident, *Response, error) {
+	// The API offers no endpoint to get a single template.
+	var resp *Response
+	templates := allPages(ctx, func(ctx context.Context, opts *ListOptions) (*[]IncidentTemplate, *Response, error) {
+		var templates *[]IncidentTemplate
+		var err error
+		templates, resp, err = (*TemplateService)(s).ListTemplates(ctx, pageID, opts)
+		return templates, resp, err
+	})
+	for template, err := range templates {
+		if err != nil {
+			return nil, resp, err
+		}
+		if template.ID != nil && *template.ID == templateID {
+			return s.CreateIncident(ctx, pageID, template.CreateIncidentParams(overrides))
+		}
+	}
+
+	return nil, resp, fmt.Errorf("%w: %q", ErrTemplateNotFound, templateID)
+}
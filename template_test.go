@@ -0,0 +1,233 @@
+package statuspage
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestTemplateService_ListTemplates(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v1/pages/1/incident_templates", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if got := r.URL.Query().Get("per_page"); got != "10" {
+			t.Errorf("per_page = %q, want %q", got, "10")
+		}
+		fmt.Fprint(w, `[{"id":"t", "name":"Outage", "components":[{"id":"c"}]}]`)
+	})
+
+	templates, _, err := client.Template.ListTemplates(context.Background(), "1", &ListOptions{PerPage: 10})
+	if err != nil {
+		t.Errorf("TemplateService.ListTemplates returned error: %v", err)
+	}
+
+	want := &[]IncidentTemplate{{ID: String("t"), Name: String("Outage"), Components: []Component{{ID: String("c")}}}}
+	if !reflect.DeepEqual(templates, want) {
+		t.Errorf("TemplateService.ListTemplates returned %+v, want %+v", templates, want)
+	}
+}
+
+func TestTemplateService_All(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v1/pages/1/incident_templates", func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Query().Get("page") {
+		case "1":
+			fmt.Fprint(w, `[`)
+			for i := 0; i < defaultPerPage; i++ {
+				if i > 0 {
+					fmt.Fprint(w, `,`)
+				}
+				fmt.Fprintf(w, `{"id":"%d"}`, i)
+			}
+			fmt.Fprint(w, `]`)
+		default:
+			fmt.Fprint(w, `[{"id":"last"}]`)
+		}
+	})
+
+	n := 0
+	for _, err := range client.Template.All(context.Background(), "1") {
+		if err != nil {
+			t.Fatalf("TemplateService.All returned error: %v", err)
+		}
+		n++
+	}
+	if n != defaultPerPage+1 {
+		t.Errorf("TemplateService.All yielded %d templates, want %d", n, defaultPerPage+1)
+	}
+}
+
+func TestTemplateService_CreateTemplate(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	input := CreateTemplateParams{
+		Name:                    "Outage",
+		Title:                   "{{service}} is down",
+		UpdateStatus:            IncidentStatusInvestigating,
+		ShouldSendNotifications: Bool(true),
+		ComponentIDs:            []string{"c"},
+	}
+
+	mux.HandleFunc("/v1/pages/1/incident_templates", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+
+		v := &CreateTemplateRequestBody{}
+		json.NewDecoder(r.Body).Decode(v)
+		if !reflect.DeepEqual(v.Template, input) {
+			t.Errorf("Request body = %+v, want %+v", v.Template, input)
+		}
+
+		fmt.Fprint(w, `{"id":"t", "update_status":"investigating"}`)
+	})
+
+	template, _, err := client.Template.CreateTemplate(context.Background(), "1", input)
+	if err != nil {
+		t.Errorf("TemplateService.CreateTemplate returned error: %v", err)
+	}
+
+	want := &IncidentTemplate{ID: String("t"), UpdateStatus: String("investigating")}
+	if !reflect.DeepEqual(template, want) {
+		t.Errorf("TemplateService.CreateTemplate returned %+v, want %+v", template, want)
+	}
+}
+
+func TestIncidentTemplate_CreateIncidentParams(t *testing.T) {
+	template := IncidentTemplate{
+		Title:                   String("API is down"),
+		Body:                    String("We are investigating."),
+		UpdateStatus:            String("identified"),
+		ShouldSendNotifications: Bool(false),
+		Components:              []Component{{ID: String("c"), Status: String("operational")}, {ID: String("d"), Status: String("major_outage")}},
+	}
+
+	params := template.CreateIncidentParams(nil)
+
+	want := CreateIncidentParams{
+		Name:                 "API is down",
+		Body:                 "We are investigating.",
+		Status:               IncidentStatusIdentified,
+		DeliverNotifications: Bool(false),
+		ComponentIDs:         []string{"c", "d"},
+		Components: map[string]ComponentStatus{
+			"c": ComponentStatusDegradedPerformance,
+			"d": ComponentStatusMajorOutage,
+		},
+	}
+	if !reflect.DeepEqual(params, want) {
+		t.Errorf("IncidentTemplate.CreateIncidentParams returned %+v, want %+v", params, want)
+	}
+}
+
+func TestIncidentTemplate_CreateIncidentParams_overrides(t *testing.T) {
+	template := IncidentTemplate{
+		Title:        String("API is down"),
+		Body:         String("We are investigating."),
+		UpdateStatus: String("investigating"),
+		Components:   []Component{{ID: String("c"), Status: String("partial_outage")}, {ID: String("d")}},
+	}
+
+	params := template.CreateIncidentParams(&IncidentTemplateOverrides{
+		Name:         "API is down in eu",
+		Status:       IncidentStatusIdentified,
+		ComponentIDs: []string{"c", "e"},
+		Components:   map[string]ComponentStatus{"e": ComponentStatusMajorOutage},
+	})
+
+	want := CreateIncidentParams{
+		Name:         "API is down in eu",
+		Body:         "We are investigating.",
+		Status:       IncidentStatusIdentified,
+		ComponentIDs: []string{"c", "e"},
+		Components: map[string]ComponentStatus{
+			"c": ComponentStatusPartialOutage,
+			"e": ComponentStatusMajorOutage,
+		},
+	}
+	if !reflect.DeepEqual(params, want) {
+		t.Errorf("IncidentTemplate.CreateIncidentParams returned %+v, want %+v", params, want)
+	}
+}
+
+func TestIncidentService_CreateIncidentFromTemplate(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v1/pages/1/incident_templates", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[{"id":"s", "title":"Other"}, {"id":"t", "title":"API is down", "update_status":"investigating", "components":[{"id":"c"}]}]`)
+	})
+	mux.HandleFunc("/v1/pages/1/incidents", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+
+		v := &CreateIncidentRequestBody{}
+		json.NewDecoder(r.Body).Decode(v)
+		want := CreateIncidentParams{
+			Name:         "API is down",
+			Body:         "Requests time out.",
+			Status:       IncidentStatusInvestigating,
+			ComponentIDs: []string{"c"},
+			Components:   map[string]ComponentStatus{"c": ComponentStatusDegradedPerformance},
+		}
+		if !reflect.DeepEqual(v.Incident, want) {
+			t.Errorf("Request body = %+v, want %+v", v.Incident, want)
+		}
+
+		fmt.Fprint(w, `{"id":"i"}`)
+	})
+
+	incident, _, err := client.Incident.CreateIncidentFromTemplate(context.Background(), "1", "t", &IncidentTemplateOverrides{Body: "Requests time out."})
+	if err != nil {
+		t.Errorf("IncidentService.CreateIncidentFromTemplate returned error: %v", err)
+	}
+
+	want := &Incident{ID: String("i")}
+	if !reflect.DeepEqual(incident, want) {
+		t.Errorf("IncidentService.CreateIncidentFromTemplate returned %+v, want %+v", incident, want)
+	}
+}
+
+func TestIncidentService_CreateIncidentFromTemplate_notFound(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v1/pages/1/incident_templates", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[{"id":"s"}]`)
+	})
+	mux.HandleFunc("/v1/pages/1/incidents", func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("Unexpected incident creation")
+	})
+
+	_, resp, err := client.Incident.CreateIncidentFromTemplate(context.Background(), "1", "t", nil)
+	if !errors.Is(err, ErrTemplateNotFound) || !IsNotFound(err) {
+		t.Errorf("IncidentService.CreateIncidentFromTemplate returned error %v, want ErrTemplateNotFound", err)
+	}
+	if resp == nil || resp.StatusCode != http.StatusOK {
+		t.Errorf("IncidentService.CreateIncidentFromTemplate returned response %v, want the last list response", resp)
+	}
+}
+
+func TestIncidentService_CreateIncidentFromTemplate_listError(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v1/pages/1/incident_templates", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, `{"error":"Forbidden"}`, http.StatusForbidden)
+	})
+
+	_, resp, err := client.Incident.CreateIncidentFromTemplate(context.Background(), "1", "t", nil)
+	if !IsForbidden(err) {
+		t.Errorf("IncidentService.CreateIncidentFromTemplate returned error %v, want 403", err)
+	}
+	if resp == nil || resp.StatusCode != http.StatusForbidden {
+		t.Errorf("IncidentService.CreateIncidentFromTemplate returned response %v, want the 403 response", resp)
+	}
+}
@@ -6,7 +6,7 @@ import (
 )
 
 // Timestamp represents a time that can be unmarshalled from a JSON string
-// formatted as either an RFC3339 or Unix timestamp.
+// formatted as either an RFC3339 or Unix timestamp, or as a plain date.
 type Timestamp struct {
 	time.Time
 }
@@ -16,7 +16,7 @@ func (t Timestamp) String() string {
 }
 
 // UnmarshalJSON implements the json.Unmarshaler interface.
-// Time is expected in RFC3339 or Unix format.
+// Time is expected in RFC3339 or Unix format, or as a date like 2006-01-02.
 func (t *Timestamp) UnmarshalJSON(data []byte) (err error) {
 	str := string(data)
 	i, err := strconv.ParseInt(str, 10, 64)
@@ -24,6 +24,11 @@ func (t *Timestamp) UnmarshalJSON(data []byte) (err error) {
 		t.Time = time.Unix(i, 0)
 	} else {
 		t.Time, err = time.Parse(`"`+time.RFC3339+`"`, str)
+		if err != nil {
+			if d, dateErr := time.Parse(`"`+time.DateOnly+`"`, str); dateErr == nil {
+				t.Time, err = d, nil
+			}
+		}
 	}
 	return
 }
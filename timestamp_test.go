@@ -0,0 +1,33 @@
+package statuspage
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestTimestamp_UnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		data string
+		want time.Time
+	}{
+		{`"2006-01-02T15:04:05Z"`, referenceTime},
+		{`1136214245`, time.Unix(1136214245, 0)},
+		{`"2006-01-02"`, time.Date(2006, time.January, 2, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		var got Timestamp
+		if err := json.Unmarshal([]byte(tt.data), &got); err != nil {
+			t.Errorf("Unmarshal(%s) returned error: %v", tt.data, err)
+		}
+		if !got.Equal(Timestamp{tt.want}) {
+			t.Errorf("Unmarshal(%s) = %v, want %v", tt.data, got, tt.want)
+		}
+	}
+
+	var ts Timestamp
+	if err := json.Unmarshal([]byte(`"yesterday"`), &ts); err == nil {
+		t.Errorf("Expected error for invalid timestamp")
+	}
+}
@@ -0,0 +1,53 @@
+package statuspage
+
+import (
+	"time"
+)
+
+// uptimeDateFormat is the format of the start and end parameters of the
+// uptime endpoints.
+const uptimeDateFormat = time.DateOnly
+
+// UptimeEvent is the Statuspage API representation of an incident that
+// affected the uptime of a component or component group
+type UptimeEvent struct {
+	ID   *string `json:"id,omitempty"`
+	Name *string `json:"name,omitempty"`
+}
+
+// Uptime is the Statuspage API uptime representation of a component or
+// component group over a date range. Outage durations are given in seconds.
+type Uptime struct {
+	ID               *string       `json:"id,omitempty"`
+	Name             *string       `json:"name,omitempty"`
+	RangeStart       *Timestamp    `json:"range_start,omitempty"`
+	RangeEnd         *Timestamp    `json:"range_end,omitempty"`
+	UptimePercentage *float64      `json:"uptime_percentage,omitempty"`
+	MajorOutage      *int64        `json:"major_outage,omitempty"`
+	PartialOutage    *int64        `json:"partial_outage,omitempty"`
+	Warnings         []string      `json:"warnings,omitempty"`
+	RelatedEvents    []UptimeEvent `json:"related_events,omitempty"`
+}
+
+func (u Uptime) String() string {
+	return Stringify(u)
+}
+
+// uptimeOptions are the query parameters of the uptime endpoints.
+type uptimeOptions struct {
+	Start string `url:"start,omitempty"`
+	End   string `url:"end,omitempty"`
+}
+
+// newUptimeOptions renders start and end in the format the uptime endpoints
+// expect. Zero times are omitted so that the API defaults apply.
+func newUptimeOptions(start, end time.Time) *uptimeOptions {
+	opts := &uptimeOptions{}
+	if !start.IsZero() {
+		opts.Start = start.Format(uptimeDateFormat)
+	}
+	if !end.IsZero() {
+		opts.End = end.Format(uptimeDateFormat)
+	}
+	return opts
+}
@@ -0,0 +1,284 @@
+package statuspage
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// defaultWatchInterval is the poll interval of a Watcher without Interval.
+const defaultWatchInterval = time.Minute
+
+// A WatchEvent is a change detected by a Watcher. It is one of
+// *ComponentStatusChangedEvent, *IncidentCreatedEvent, *IncidentUpdatedEvent,
+// *IncidentResolvedEvent, *MaintenanceStartedEvent or *WatchErrorEvent.
+type WatchEvent interface {
+	// PageDomain returns the domain of the status page the event occurred on.
+	PageDomain() string
+}
+
+// ComponentStatusChangedEvent is emitted when a component changes its status.
+type ComponentStatusChangedEvent struct {
+	Domain    string
+	Component Component
+	OldStatus string
+	NewStatus string
+}
+
+// IncidentCreatedEvent is emitted when an unresolved incident shows up.
+type IncidentCreatedEvent struct {
+	Domain   string
+	Incident Incident
+}
+
+// IncidentUpdatedEvent is emitted when the status of an unresolved incident
+// changes or an update is posted to it. Previous is the incident as seen by
+// the poll before.
+type IncidentUpdatedEvent struct {
+	Domain   string
+	Incident Incident
+	Previous Incident
+}
+
+// IncidentResolvedEvent is emitted when an incident is resolved. The public
+// API drops resolved incidents from the summary, so Incident may be the last
+// unresolved state seen.
+type IncidentResolvedEvent struct {
+	Domain   string
+	Incident Incident
+}
+
+// MaintenanceStartedEvent is emitted when a scheduled maintenance moves to in
+// progress.
+type MaintenanceStartedEvent struct {
+	Domain      string
+	Maintenance Incident
+}
+
+// WatchErrorEvent is emitted when polling a page fails. The Watcher keeps
+// polling the page at its regular interval.
+type WatchErrorEvent struct {
+	Domain string
+	Err    error
+}
+
+func (e *ComponentStatusChangedEvent) PageDomain() string { return e.Domain }
+func (e *IncidentCreatedEvent) PageDomain() string        { return e.Domain }
+func (e *IncidentUpdatedEvent) PageDomain() string        { return e.Domain }
+func (e *IncidentResolvedEvent) PageDomain() string       { return e.Domain }
+func (e *MaintenanceStartedEvent) PageDomain() string     { return e.Domain }
+func (e *WatchErrorEvent) PageDomain() string             { return e.Domain }
+
+// Watcher polls the public status API of one or many status pages and emits
+// a WatchEvent for every change between two successive polls. The first poll
+// of a page only records its state. Conditional requests keep polls of pages
+// that did not change cheap.
+type Watcher struct {
+	// Interval is the time between two polls of a page. Defaults to one
+	// minute.
+	Interval time.Duration
+
+	pages []*PublicClient
+}
+
+// NewWatcher returns a Watcher polling the given pages every interval.
+func NewWatcher(interval time.Duration, pages ...*PublicClient) *Watcher {
+	return &Watcher{Interval: interval, pages: pages}
+}
+
+// Watch starts polling all pages and returns the channel events are sent on.
+// Polling stops and the channel is closed once ctx is done.
+func (w *Watcher) Watch(ctx context.Context) <-chan WatchEvent {
+	interval := w.Interval
+	if interval <= 0 {
+		interval = defaultWatchInterval
+	}
+
+	events := make(chan WatchEvent)
+	var wg sync.WaitGroup
+	for _, page := range w.pages {
+		wg.Add(1)
+		go func(page *PublicClient) {
+			defer wg.Done()
+			watchPage(ctx, page, interval, events)
+		}(page)
+	}
+	go func() {
+		wg.Wait()
+		close(events)
+	}()
+
+	return events
+}
+
+// pageSnapshot is the state of a page as seen by its last poll.
+type pageSnapshot struct {
+	summary      *PublicSummary
+	etag         string
+	lastModified string
+}
+
+func watchPage(ctx context.Context, page *PublicClient, interval time.Duration, events chan<- WatchEvent) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	var snapshot pageSnapshot
+	for {
+		for _, event := range snapshot.poll(ctx, page) {
+			select {
+			case events <- event:
+			case <-ctx.Done():
+				return
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// poll fetches the summary of page unless it did not change since the last
+// poll and returns the events between both summaries.
+func (s *pageSnapshot) poll(ctx context.Context, page *PublicClient) []WatchEvent {
+	summary, resp, err := page.getSummaryIfModified(ctx, s.etag, s.lastModified)
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil
+		}
+		return []WatchEvent{&WatchErrorEvent{Domain: page.Domain(), Err: err}}
+	}
+	if summary == nil {
+		return nil
+	}
+
+	s.etag = resp.Header.Get("ETag")
+	s.lastModified = resp.Header.Get("Last-Modified")
+
+	previous := s.summary
+	s.summary = summary
+	if previous == nil {
+		return nil
+	}
+	return diffSummaries(page.Domain(), previous, summary)
+}
+
+// getSummaryIfModified returns the summary of the page, or nil if the page
+// did not change since the response carrying etag and lastModified.
+func (c *PublicClient) getSummaryIfModified(ctx context.Context, etag, lastModified string) (*PublicSummary, *Response, error) {
+	req, err := c.client.newRequest("GET", "api/v2/summary.json", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	if etag != "" {
+		req.Header.Set("If-None-Match", etag)
+	}
+	if lastModified != "" {
+		req.Header.Set("If-Modified-Since", lastModified)
+	}
+
+	var summary PublicSummary
+	resp, err := c.client.do(ctx, req, &summary)
+	if err != nil || resp.StatusCode == http.StatusNotModified {
+		return nil, resp, err
+	}
+
+	return &summary, resp, nil
+}
+
+// diffSummaries returns the events that lead from the summary before to after.
+func diffSummaries(domain string, before, after *PublicSummary) []WatchEvent {
+	var events []WatchEvent
+
+	oldComponents := map[string]Component{}
+	for _, component := range before.Components {
+		if component.ID != nil {
+			oldComponents[*component.ID] = component
+		}
+	}
+	for _, component := range after.Components {
+		if component.ID == nil {
+			continue
+		}
+		previous, ok := oldComponents[*component.ID]
+		if !ok {
+			continue
+		}
+		if oldStatus, newStatus := stringValue(previous.Status), stringValue(component.Status); oldStatus != newStatus {
+			events = append(events, &ComponentStatusChangedEvent{
+				Domain:    domain,
+				Component: component,
+				OldStatus: oldStatus,
+				NewStatus: newStatus,
+			})
+		}
+	}
+
+	oldIncidents := incidentsByID(before.Incidents)
+	newIncidents := incidentsByID(after.Incidents)
+	for _, incident := range after.Incidents {
+		if incident.ID == nil {
+			continue
+		}
+		previous, ok := oldIncidents[*incident.ID]
+		switch {
+		case stringValue(incident.Status) == string(IncidentStatusResolved):
+			if !ok || stringValue(previous.Status) != string(IncidentStatusResolved) {
+				events = append(events, &IncidentResolvedEvent{Domain: domain, Incident: incident})
+			}
+		case !ok:
+			events = append(events, &IncidentCreatedEvent{Domain: domain, Incident: incident})
+		case stringValue(previous.Status) != stringValue(incident.Status) ||
+			!timestampEqual(previous.UpdatedAt, incident.UpdatedAt):
+			events = append(events, &IncidentUpdatedEvent{Domain: domain, Incident: incident, Previous: previous})
+		}
+	}
+	for _, incident := range before.Incidents {
+		if incident.ID == nil || stringValue(incident.Status) == string(IncidentStatusResolved) {
+			continue
+		}
+		if _, ok := newIncidents[*incident.ID]; !ok {
+			events = append(events, &IncidentResolvedEvent{Domain: domain, Incident: incident})
+		}
+	}
+
+	oldMaintenances := incidentsByID(before.ScheduledMaintenances)
+	for _, maintenance := range after.ScheduledMaintenances {
+		if maintenance.ID == nil || stringValue(maintenance.Status) != string(IncidentStatusInProgress) {
+			continue
+		}
+		previous, ok := oldMaintenances[*maintenance.ID]
+		if !ok || stringValue(previous.Status) != string(IncidentStatusInProgress) {
+			events = append(events, &MaintenanceStartedEvent{Domain: domain, Maintenance: maintenance})
+		}
+	}
+
+	return events
+}
+
+func incidentsByID(incidents []Incident) map[string]Incident {
+	m := make(map[string]Incident, len(incidents))
+	for _, incident := range incidents {
+		if incident.ID != nil {
+			m[*incident.ID] = incident
+		}
+	}
+	return m
+}
+
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+func timestampEqual(a, b *Timestamp) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Equal(*b)
+}
@@ -0,0 +1,182 @@
+package statuspage
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestDiffSummaries(t *testing.T) {
+	updatedAt := &Timestamp{referenceTime}
+	laterUpdatedAt := &Timestamp{referenceTime.Add(time.Minute)}
+
+	before := &PublicSummary{
+		Components: []Component{
+			{ID: String("a"), Status: String("operational")},
+			{ID: String("b"), Status: String("operational")},
+		},
+		Incidents: []Incident{
+			{ID: String("i1"), Status: String("investigating"), UpdatedAt: updatedAt},
+			{ID: String("i2"), Status: String("identified"), UpdatedAt: updatedAt},
+			{ID: String("i3"), Status: String("monitoring"), UpdatedAt: updatedAt},
+			{ID: String("i4"), Status: String("monitoring"), UpdatedAt: updatedAt},
+		},
+		ScheduledMaintenances: []Incident{
+			{ID: String("m1"), Status: String("scheduled")},
+			{ID: String("m2"), Status: String("in_progress")},
+		},
+	}
+	after := &PublicSummary{
+		Components: []Component{
+			{ID: String("a"), Status: String("major_outage")},
+			{ID: String("b"), Status: String("operational")},
+			{ID: String("c"), Status: String("operational")},
+		},
+		Incidents: []Incident{
+			{ID: String("i1"), Status: String("investigating"), UpdatedAt: updatedAt},
+			{ID: String("i2"), Status: String("identified"), UpdatedAt: laterUpdatedAt},
+			{ID: String("i3"), Status: String("resolved"), UpdatedAt: laterUpdatedAt},
+			{ID: String("i5"), Status: String("investigating")},
+		},
+		ScheduledMaintenances: []Incident{
+			{ID: String("m1"), Status: String("in_progress")},
+			{ID: String("m2"), Status: String("in_progress")},
+		},
+	}
+
+	events := diffSummaries("d", before, after)
+
+	want := []WatchEvent{
+		&ComponentStatusChangedEvent{Domain: "d", Component: after.Components[0], OldStatus: "operational", NewStatus: "major_outage"},
+		&IncidentUpdatedEvent{Domain: "d", Incident: after.Incidents[1], Previous: before.Incidents[1]},
+		&IncidentResolvedEvent{Domain: "d", Incident: after.Incidents[2]},
+		&IncidentCreatedEvent{Domain: "d", Incident: after.Incidents[3]},
+		&IncidentResolvedEvent{Domain: "d", Incident: before.Incidents[3]},
+		&MaintenanceStartedEvent{Domain: "d", Maintenance: after.ScheduledMaintenances[0]},
+	}
+	if !reflect.DeepEqual(events, want) {
+		t.Errorf("diffSummaries returned %+v, want %+v", events, want)
+	}
+}
+
+func TestDiffSummaries_unchanged(t *testing.T) {
+	summary := &PublicSummary{
+		Components: []Component{{ID: String("a"), Status: String("operational")}},
+		Incidents:  []Incident{{ID: String("i"), Status: String("investigating")}},
+	}
+
+	if events := diffSummaries("d", summary, summary); len(events) != 0 {
+		t.Errorf("diffSummaries returned %+v, want no events", events)
+	}
+}
+
+func TestWatcher_Watch(t *testing.T) {
+	client, mux, teardown := setupPublic()
+	defer teardown()
+
+	var mu sync.Mutex
+	polls := 0
+	mux.HandleFunc("/api/v2/summary.json", func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		polls++
+		poll := polls
+		mu.Unlock()
+
+		switch poll {
+		case 1:
+			w.Header().Set("ETag", `"v1"`)
+			fmt.Fprint(w, `{"components": [{"id": "a", "status": "operational"}]}`)
+		case 2:
+			if got := r.Header.Get("If-None-Match"); got != `"v1"` {
+				t.Errorf("If-None-Match = %q, want %q", got, `"v1"`)
+			}
+			w.WriteHeader(http.StatusNotModified)
+		default:
+			w.Header().Set("ETag", `"v2"`)
+			fmt.Fprint(w, `{"components": [{"id": "a", "status": "partial_outage"}]}`)
+		}
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	events := NewWatcher(10*time.Millisecond, client).Watch(ctx)
+
+	select {
+	case event := <-events:
+		want := &ComponentStatusChangedEvent{
+			Domain:    "status.example.com",
+			Component: Component{ID: String("a"), Status: String("partial_outage")},
+			OldStatus: "operational",
+			NewStatus: "partial_outage",
+		}
+		if !reflect.DeepEqual(event, want) {
+			t.Errorf("Watcher emitted %+v, want %+v", event, want)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("Timed out waiting for an event")
+	}
+
+	mu.Lock()
+	if polls != 3 {
+		t.Errorf("Got %d polls before the first event, want 3", polls)
+	}
+	mu.Unlock()
+
+	cancel()
+	for range events {
+	}
+}
+
+func TestWatcher_Watch_error(t *testing.T) {
+	client, mux, teardown := setupPublic()
+	defer teardown()
+
+	mux.HandleFunc("/api/v2/summary.json", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "Bad Gateway", http.StatusBadGateway)
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	events := NewWatcher(time.Hour, client).Watch(ctx)
+
+	select {
+	case event := <-events:
+		e, ok := event.(*WatchErrorEvent)
+		if !ok {
+			t.Fatalf("Watcher emitted %T, want *WatchErrorEvent", event)
+		}
+		if e.PageDomain() != "status.example.com" {
+			t.Errorf("WatchErrorEvent.PageDomain() = %q, want %q", e.PageDomain(), "status.example.com")
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("Timed out waiting for an event")
+	}
+}
+
+func TestWatcher_Watch_cancel(t *testing.T) {
+	client, mux, teardown := setupPublic()
+	defer teardown()
+
+	mux.HandleFunc("/api/v2/summary.json", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{}`)
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	events := NewWatcher(time.Millisecond, client, client).Watch(ctx)
+	cancel()
+
+	select {
+	case _, ok := <-events:
+		for ok {
+			_, ok = <-events
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("Watcher did not close its channel after cancellation")
+	}
+}
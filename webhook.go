@@ -0,0 +1,196 @@
+package statuspage
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+)
+
+// DefaultMaxWebhookBodySize is the largest webhook body a WebhookHandler
+// accepts unless configured otherwise.
+const DefaultMaxWebhookBodySize = 1 << 20
+
+// WebhookMeta is the meta section of a Statuspage webhook payload
+type WebhookMeta struct {
+	Unsubscribe   *string `json:"unsubscribe,omitempty"`
+	Documentation *string `json:"documentation,omitempty"`
+}
+
+// WebhookPage is the page section of a Statuspage webhook payload
+type WebhookPage struct {
+	ID                *string `json:"id,omitempty"`
+	StatusIndicator   *string `json:"status_indicator,omitempty"`
+	StatusDescription *string `json:"status_description,omitempty"`
+}
+
+// ComponentUpdate is the Statuspage webhook representation of a component
+// status change
+type ComponentUpdate struct {
+	ID          *string    `json:"id,omitempty"`
+	ComponentID *string    `json:"component_id,omitempty"`
+	CreatedAt   *Timestamp `json:"created_at,omitempty"`
+	OldStatus   *string    `json:"old_status,omitempty"`
+	NewStatus   *string    `json:"new_status,omitempty"`
+}
+
+func (u ComponentUpdate) String() string {
+	return Stringify(u)
+}
+
+// ComponentUpdateEvent is the webhook payload sent when a component changes
+// its status
+//
+// Statuspage docs: https://support.atlassian.com/statuspage/docs/enable-webhook-notifications/
+type ComponentUpdateEvent struct {
+	Meta            *WebhookMeta     `json:"meta,omitempty"`
+	Page            *WebhookPage     `json:"page,omitempty"`
+	ComponentUpdate *ComponentUpdate `json:"component_update,omitempty"`
+	Component       *Component       `json:"component,omitempty"`
+}
+
+func (e ComponentUpdateEvent) String() string {
+	return Stringify(e)
+}
+
+// IncidentUpdateEvent is the webhook payload sent when an incident is
+// created or updated
+//
+// Statuspage docs: https://support.atlassian.com/statuspage/docs/enable-webhook-notifications/
+type IncidentUpdateEvent struct {
+	Meta     *WebhookMeta `json:"meta,omitempty"`
+	Page     *WebhookPage `json:"page,omitempty"`
+	Incident *Incident    `json:"incident,omitempty"`
+}
+
+func (e IncidentUpdateEvent) String() string {
+	return Stringify(e)
+}
+
+// ParseWebhook parses a Statuspage webhook payload and returns either a
+// *ComponentUpdateEvent or an *IncidentUpdateEvent.
+func ParseWebhook(payload []byte) (interface{}, error) {
+	var kind struct {
+		ComponentUpdate json.RawMessage `json:"component_update"`
+		Incident        json.RawMessage `json:"incident"`
+	}
+	if err := json.Unmarshal(payload, &kind); err != nil {
+		return nil, err
+	}
+
+	switch {
+	case len(kind.ComponentUpdate) > 0 && string(kind.ComponentUpdate) != "null":
+		event := &ComponentUpdateEvent{}
+		if err := json.Unmarshal(payload, event); err != nil {
+			return nil, err
+		}
+		return event, nil
+	case len(kind.Incident) > 0 && string(kind.Incident) != "null":
+		event := &IncidentUpdateEvent{}
+		if err := json.Unmarshal(payload, event); err != nil {
+			return nil, err
+		}
+		return event, nil
+	}
+
+	return nil, errors.New("unknown webhook payload")
+}
+
+// WebhookHandler is an http.Handler receiving Statuspage webhooks. It parses
+// every request with ParseWebhook and calls the callbacks registered for the
+// event. Requests other than POST, malformed payloads and bodies larger than
+// MaxBodySize are rejected.
+//
+// Callbacks run on the request goroutine. If one returns an error, the
+// handler responds with 500 Internal Server Error and skips the remaining
+// callbacks.
+type WebhookHandler struct {
+	// MaxBodySize is the largest body accepted, in bytes. Defaults to
+	// DefaultMaxWebhookBodySize.
+	MaxBodySize int64
+
+	mu                sync.RWMutex
+	componentHandlers []func(context.Context, *ComponentUpdateEvent) error
+	incidentHandlers  []func(context.Context, *IncidentUpdateEvent) error
+}
+
+// NewWebhookHandler returns a WebhookHandler without callbacks.
+func NewWebhookHandler() *WebhookHandler {
+	return &WebhookHandler{MaxBodySize: DefaultMaxWebhookBodySize}
+}
+
+// OnComponentUpdate registers fn to be called for every component update.
+func (h *WebhookHandler) OnComponentUpdate(fn func(context.Context, *ComponentUpdateEvent) error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.componentHandlers = append(h.componentHandlers, fn)
+}
+
+// OnIncidentUpdate registers fn to be called for every incident update.
+func (h *WebhookHandler) OnIncidentUpdate(fn func(context.Context, *IncidentUpdateEvent) error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.incidentHandlers = append(h.incidentHandlers, fn)
+}
+
+// ServeHTTP implements the http.Handler interface.
+func (h *WebhookHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	maxBodySize := h.MaxBodySize
+	if maxBodySize <= 0 {
+		maxBodySize = DefaultMaxWebhookBodySize
+	}
+	payload, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxBodySize))
+	if err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			http.Error(w, "request body too large", http.StatusRequestEntityTooLarge)
+			return
+		}
+		http.Error(w, "error reading request body", http.StatusBadRequest)
+		return
+	}
+
+	event, err := ParseWebhook(payload)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("invalid webhook payload: %v", err), http.StatusBadRequest)
+		return
+	}
+
+	if err := h.dispatch(r.Context(), event); err != nil {
+		http.Error(w, "error handling webhook", http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *WebhookHandler) dispatch(ctx context.Context, event interface{}) error {
+	h.mu.RLock()
+	componentHandlers, incidentHandlers := h.componentHandlers, h.incidentHandlers
+	h.mu.RUnlock()
+
+	switch event := event.(type) {
+	case *ComponentUpdateEvent:
+		for _, fn := range componentHandlers {
+			if err := fn(ctx, event); err != nil {
+				return err
+			}
+		}
+	case *IncidentUpdateEvent:
+		for _, fn := range incidentHandlers {
+			if err := fn(ctx, event); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,207 @@
+package statuspage
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const componentUpdatePayload = `{
+	"meta": {
+		"unsubscribe": "https://status.example.com/?unsubscribe=j0vqr9kl3513",
+		"documentation": "https://doers.statuspage.io/customer-notifications/webhooks/"
+	},
+	"page": {
+		"id": "j2mfxwj97wnj",
+		"status_indicator": "major",
+		"status_description": "Partial System Outage"
+	},
+	"component_update": {
+		"created_at": "2006-01-02T15:04:05Z",
+		"new_status": "operational",
+		"old_status": "major_outage",
+		"id": "k7730b5v92bv",
+		"component_id": "rb5wq1dczvbm"
+	},
+	"component": {
+		"created_at": "2006-01-02T15:04:05Z",
+		"id": "rb5wq1dczvbm",
+		"name": "Some Component",
+		"status": "operational"
+	}
+}`
+
+const incidentUpdatePayload = `{
+	"meta": {
+		"unsubscribe": "https://status.example.com/?unsubscribe=j0vqr9kl3513",
+		"documentation": "https://doers.statuspage.io/customer-notifications/webhooks/"
+	},
+	"page": {
+		"id": "j2mfxwj97wnj",
+		"status_indicator": "critical",
+		"status_description": "Major System Outage"
+	},
+	"incident": {
+		"created_at": "2006-01-02T15:04:05Z",
+		"id": "lbkhbwn21v5q",
+		"impact": "critical",
+		"impact_override": null,
+		"incident_updates": [{
+			"body": "We are investigating.",
+			"created_at": "2006-01-02T15:04:05Z",
+			"id": "drfcwbnpxnr6",
+			"incident_id": "lbkhbwn21v5q",
+			"status": "investigating"
+		}],
+		"name": "Database outage",
+		"status": "investigating"
+	}
+}`
+
+func TestParseWebhook_componentUpdate(t *testing.T) {
+	event, err := ParseWebhook([]byte(componentUpdatePayload))
+	if err != nil {
+		t.Fatalf("ParseWebhook returned error: %v", err)
+	}
+
+	want := &ComponentUpdateEvent{
+		Meta: &WebhookMeta{
+			Unsubscribe:   String("https://status.example.com/?unsubscribe=j0vqr9kl3513"),
+			Documentation: String("https://doers.statuspage.io/customer-notifications/webhooks/"),
+		},
+		Page: &WebhookPage{
+			ID:                String("j2mfxwj97wnj"),
+			StatusIndicator:   String("major"),
+			StatusDescription: String("Partial System Outage"),
+		},
+		ComponentUpdate: &ComponentUpdate{
+			ID:          String("k7730b5v92bv"),
+			ComponentID: String("rb5wq1dczvbm"),
+			CreatedAt:   &Timestamp{referenceTime},
+			OldStatus:   String("major_outage"),
+			NewStatus:   String("operational"),
+		},
+		Component: &Component{
+			ID:        String("rb5wq1dczvbm"),
+			CreatedAt: &Timestamp{referenceTime},
+			Name:      String("Some Component"),
+			Status:    String("operational"),
+		},
+	}
+	if !reflect.DeepEqual(event, want) {
+		t.Errorf("ParseWebhook returned %+v, want %+v", event, want)
+	}
+}
+
+func TestParseWebhook_incidentUpdate(t *testing.T) {
+	event, err := ParseWebhook([]byte(incidentUpdatePayload))
+	if err != nil {
+		t.Fatalf("ParseWebhook returned error: %v", err)
+	}
+
+	e, ok := event.(*IncidentUpdateEvent)
+	if !ok {
+		t.Fatalf("ParseWebhook returned %T, want *IncidentUpdateEvent", event)
+	}
+
+	want := &Incident{
+		ID:        String("lbkhbwn21v5q"),
+		Name:      String("Database outage"),
+		Status:    String("investigating"),
+		Impact:    String("critical"),
+		CreatedAt: &Timestamp{referenceTime},
+		IncidentUpdates: []IncidentUpdate{{
+			ID:         String("drfcwbnpxnr6"),
+			IncidentID: String("lbkhbwn21v5q"),
+			Body:       String("We are investigating."),
+			CreatedAt:  &Timestamp{referenceTime},
+			Status:     String("investigating"),
+		}},
+	}
+	if !reflect.DeepEqual(e.Incident, want) {
+		t.Errorf("ParseWebhook returned incident %+v, want %+v", e.Incident, want)
+	}
+	if got := *e.Page.StatusIndicator; got != "critical" {
+		t.Errorf("ParseWebhook returned status indicator %q, want %q", got, "critical")
+	}
+}
+
+func TestParseWebhook_invalid(t *testing.T) {
+	tests := []string{
+		``,
+		`not json`,
+		`[]`,
+		`{}`,
+		`{"page": {"id": "p"}}`,
+		`{"component_update": null}`,
+		`{"component_update": "x"}`,
+	}
+
+	for _, payload := range tests {
+		if _, err := ParseWebhook([]byte(payload)); err == nil {
+			t.Errorf("Expected error for payload %q", payload)
+		}
+	}
+}
+
+func TestWebhookHandler(t *testing.T) {
+	h := NewWebhookHandler()
+
+	var components []*ComponentUpdateEvent
+	var incidents []*IncidentUpdateEvent
+	h.OnComponentUpdate(func(ctx context.Context, e *ComponentUpdateEvent) error {
+		components = append(components, e)
+		return nil
+	})
+	h.OnIncidentUpdate(func(ctx context.Context, e *IncidentUpdateEvent) error {
+		incidents = append(incidents, e)
+		return nil
+	})
+
+	for _, payload := range []string{componentUpdatePayload, incidentUpdatePayload} {
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, httptest.NewRequest("POST", "/", strings.NewReader(payload)))
+		if w.Code != http.StatusNoContent {
+			t.Errorf("WebhookHandler responded %d, want %d", w.Code, http.StatusNoContent)
+		}
+	}
+
+	if len(components) != 1 || *components[0].Component.ID != "rb5wq1dczvbm" {
+		t.Errorf("Component update callbacks got %+v, want one event", components)
+	}
+	if len(incidents) != 1 || *incidents[0].Incident.ID != "lbkhbwn21v5q" {
+		t.Errorf("Incident update callbacks got %+v, want one event", incidents)
+	}
+}
+
+func TestWebhookHandler_rejects(t *testing.T) {
+	h := NewWebhookHandler()
+	h.MaxBodySize = 64
+	h.OnComponentUpdate(func(ctx context.Context, e *ComponentUpdateEvent) error {
+		return errors.New("boom")
+	})
+
+	tests := []struct {
+		method string
+		body   string
+		want   int
+	}{
+		{"GET", "", http.StatusMethodNotAllowed},
+		{"POST", "not json", http.StatusBadRequest},
+		{"POST", `{"page": {"id": "p"}}`, http.StatusBadRequest},
+		{"POST", `{"component_update": {"id": "` + strings.Repeat("a", 64) + `"}}`, http.StatusRequestEntityTooLarge},
+		{"POST", `{"component_update": {"id": "u"}}`, http.StatusInternalServerError},
+	}
+
+	for _, tt := range tests {
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, httptest.NewRequest(tt.method, "/", strings.NewReader(tt.body)))
+		if w.Code != tt.want {
+			t.Errorf("WebhookHandler responded %d to %s %q, want %d", w.Code, tt.method, tt.body, tt.want)
+		}
+	}
+}